@@ -0,0 +1,41 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18
+// +build go1.18
+
+package c128
+
+import (
+	"math/rand"
+
+	"github.com/gonum/internal/asm/internal/asmtest"
+)
+
+// otherAccuracyTests are the accuracy tests for the kernels that are not
+// generated by asmgen. The matrix-vector kernels are checked with a single
+// row or column, for which each element of y is a dot product.
+var otherAccuracyTests = []accuracyTest{
+	{name: "GemvN", incs: asmtest.AccuracyIncs, acc: asmtest.Accuracy{Cond: 1}, kernel: func(rnd *rand.Rand, n, inc int, acc asmtest.Accuracy) error {
+		a := asmtest.AccuracyVector[complex128](rnd, n, 1)
+		x := asmtest.AccuracyVector[complex128](rnd, n, inc)
+		y := make([]complex128, 1)
+		GemvN(1, uintptr(n), 1, a, uintptr(n), x, uintptr(inc), 0, y, 1)
+		return asmtest.CompareExact(y[0], asmtest.ExactDot(a, asmtest.Elems(x, n, inc)), acc)
+	}},
+	{name: "GemvT", incs: asmtest.AccuracyIncs, acc: asmtest.Accuracy{Cond: 1}, kernel: func(rnd *rand.Rand, n, inc int, acc asmtest.Accuracy) error {
+		a := asmtest.AccuracyVector[complex128](rnd, n, 1)
+		x := asmtest.AccuracyVector[complex128](rnd, n, inc)
+		y := make([]complex128, 1)
+		GemvT(uintptr(n), 1, 1, a, 1, x, uintptr(inc), 0, y, 1)
+		return asmtest.CompareExact(y[0], asmtest.ExactDot(a, asmtest.Elems(x, n, inc)), acc)
+	}},
+	{name: "GemvC", incs: asmtest.AccuracyIncs, acc: asmtest.Accuracy{Cond: 1}, kernel: func(rnd *rand.Rand, n, inc int, acc asmtest.Accuracy) error {
+		a := asmtest.AccuracyVector[complex128](rnd, n, 1)
+		x := asmtest.AccuracyVector[complex128](rnd, n, inc)
+		y := make([]complex128, 1)
+		GemvC(uintptr(n), 1, 1, a, 1, x, uintptr(inc), 0, y, 1)
+		return asmtest.CompareExact(y[0], asmtest.ExactDot(conjElems(a), asmtest.Elems(x, n, inc)), acc)
+	}},
+}
@@ -0,0 +1,94 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+#include "textflag.h"
+
+// MOVDDUP X2, X3
+#define MOVDDUP_X2_X3 BYTE $0xF2; BYTE $0x0F; BYTE $0x12; BYTE $0xDA
+// MOVDDUP X4, X5
+#define MOVDDUP_X4_X5 BYTE $0xF2; BYTE $0x0F; BYTE $0x12; BYTE $0xEC
+
+// ADDSUBPD X2, X3
+#define ADDSUBPD_X2_X3 BYTE $0x66; BYTE $0x0F; BYTE $0xD0; BYTE $0xDA
+// ADDSUBPD X4, X5
+#define ADDSUBPD_X4_X5 BYTE $0x66; BYTE $0x0F; BYTE $0xD0; BYTE $0xEC
+
+// func axpyConjUnitary(alpha complex128, x, y []complex128)
+TEXT ·axpyConjUnitary(SB), NOSPLIT, $0
+	MOVQ    x_base+16(FP), SI // SI = &x
+	MOVQ    y_base+40(FP), DI // DI = &y
+	MOVQ    x_len+24(FP), CX  // CX = min( len(x), len(y) )
+	CMPQ    y_len+48(FP), CX
+	CMOVQLE y_len+48(FP), CX
+	CMPQ    CX, $0            // if CX == 0 { return }
+	JE      caxyc_end
+	MOVUPS  alpha+0(FP), X0   // X0 = { imag(a), real(a) }
+	MOVAPS  X0, X1
+	SHUFPD  $0x1, X1, X1      // X1 = { real(a), imag(a) }
+	PCMPEQL X8, X8            // X8 = { -0, 0 }
+	PSLLQ   $63, X8
+	PSLLO   $8, X8
+	XORQ    AX, AX            // i = 0
+	MOVQ    CX, BX
+	ANDQ    $1, CX            // CX = n % 2
+	SHRQ    $1, BX            // BX = floor( n / 2 )
+	JZ      caxyc_tail        // if BX == 0 { goto caxyc_tail }
+
+caxyc_loop: // do {
+	MOVUPS (SI)(AX*8), X2   // X_i = { imag(x[i]), real(x[i]) }
+	MOVUPS 16(SI)(AX*8), X4
+	XORPD  X8, X2           // X_i = { -imag(x[i]), real(x[i]) }
+	XORPD  X8, X4
+
+	// X_(i+1) = { real(x[i]), real(x[i]) }
+	MOVDDUP_X2_X3
+	MOVDDUP_X4_X5
+
+	// X_i = { -imag(x[i]), -imag(x[i]) }
+	SHUFPD $0x3, X2, X2
+	SHUFPD $0x3, X4, X4
+
+	// X_i     = { real(a) * -imag(x[i]), imag(a) * -imag(x[i]) }
+	// X_(i+1) = { imag(a) * real(x[i]), real(a) * real(x[i]) }
+	MULPD X1, X2
+	MULPD X0, X3
+	MULPD X1, X4
+	MULPD X0, X5
+
+	// X_(i+1) = {
+	//	imag(result[i]):  imag(a)*real(x[i]) - real(a)*imag(x[i]),
+	//	real(result[i]):  real(a)*real(x[i]) + imag(a)*imag(x[i])
+	//  }
+	ADDSUBPD_X2_X3
+	ADDSUBPD_X4_X5
+
+	// X_(i+1) = { imag(result[i]) + imag(y[i]), real(result[i]) + real(y[i]) }
+	MOVUPS (DI)(AX*8), X6
+	MOVUPS 16(DI)(AX*8), X7
+	ADDPD  X6, X3
+	ADDPD  X7, X5
+	MOVUPS X3, (DI)(AX*8)   // y[i] = X_(i+1)
+	MOVUPS X5, 16(DI)(AX*8)
+	ADDQ   $4, AX           // i += 2
+	DECQ   BX
+	JNZ    caxyc_loop       // } while --BX > 0
+	CMPQ   CX, $0           // if CX == 0 { return }
+	JE     caxyc_end
+
+caxyc_tail:
+	MOVUPS (SI)(AX*8), X2 // X2 = { imag(x[i]), real(x[i]) }
+	XORPD  X8, X2         // X2 = { -imag(x[i]), real(x[i]) }
+	MOVDDUP_X2_X3         // X3 = { real(x[i]), real(x[i]) }
+	SHUFPD $0x3, X2, X2   // X2 = { -imag(x[i]), -imag(x[i]) }
+	MULPD  X1, X2         // X2 = { real(a) * -imag(x[i]), imag(a) * -imag(x[i]) }
+	MULPD  X0, X3         // X3 = { imag(a) * real(x[i]), real(a) * real(x[i]) }
+	ADDSUBPD_X2_X3        // X3 = a * conj(x[i])
+	MOVUPS (DI)(AX*8), X6 // X3 += y[i]
+	ADDPD  X6, X3
+	MOVUPS X3, (DI)(AX*8) // y[i] = X3
+
+caxyc_end:
+	RET
@@ -6,4 +6,12 @@
 // Development has moved to https://github.com/gonum/gonum.
 //
 // Package c128 provides complex128 vector primitives.
+//
+// The dot product and matrix-vector kernels may add their terms in any
+// order. Each part of a complex product contributes two terms, and a
+// result part formed from m terms has an error of at most γ(m) times the
+// sum of the absolute values of the terms, where γ(m) = m·u/(1-m·u) and
+// u = 2^-53.
 package c128
+
+//go:generate go run ../internal/asmgen -pkg c128
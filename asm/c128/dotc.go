@@ -6,18 +6,6 @@ package c128
 
 import "math/cmplx"
 
-// DotcUnitary is
-//  for i, v := range x {
-//  	sum += y[i] * cmplx.Conj(v)
-//  }
-//  return sum
-func DotcUnitary(x, y []complex128) (sum complex128) {
-	for i, v := range x {
-		sum += y[i] * cmplx.Conj(v)
-	}
-	return sum
-}
-
 // DotcInc is
 //  for i := 0; i < int(n); i++ {
 //  	sum += y[iy] * cmplx.Conj(x[ix])
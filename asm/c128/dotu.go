@@ -4,18 +4,6 @@
 
 package c128
 
-// DotuUnitary is
-//  for i, v := range x {
-//  	sum += y[i] * v
-//  }
-//  return sum
-func DotuUnitary(x, y []complex128) (sum complex128) {
-	for i, v := range x {
-		sum += y[i] * v
-	}
-	return sum
-}
-
 // DotuInc is
 //  for i := 0; i < int(n); i++ {
 //  	sum += y[iy] * x[ix]
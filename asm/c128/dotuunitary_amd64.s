@@ -0,0 +1,89 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+#include "textflag.h"
+
+// MOVDDUP X4, X5
+#define MOVDDUP_X4_X5 BYTE $0xF2; BYTE $0x0F; BYTE $0x12; BYTE $0xEC
+// MOVDDUP X6, X7
+#define MOVDDUP_X6_X7 BYTE $0xF2; BYTE $0x0F; BYTE $0x12; BYTE $0xFE
+
+// ADDSUBPD X1, X0
+#define ADDSUBPD_X1_X0 BYTE $0x66; BYTE $0x0F; BYTE $0xD0; BYTE $0xC1
+
+// func DotuUnitary(x, y []complex128) (sum complex128)
+TEXT ·DotuUnitary(SB), NOSPLIT, $0
+	MOVQ    x_base+0(FP), SI  // SI = &x
+	MOVQ    y_base+24(FP), DI // DI = &y
+	MOVQ    x_len+8(FP), CX   // CX = min( len(x), len(y) )
+	CMPQ    y_len+32(FP), CX
+	CMOVQLE y_len+32(FP), CX
+	PXOR    X0, X0            // X0 = P = { 0, 0 }
+	PXOR    X1, X1            // X1 = Q = { 0, 0 }
+	CMPQ    CX, $0            // if CX == 0 { return 0 }
+	JE      dot_end
+	PXOR    X2, X2            // Second accumulator pair for pipelining
+	PXOR    X3, X3
+	XORQ    AX, AX            // i = 0
+	MOVQ    CX, BX
+	ANDQ    $1, CX            // CX = n % 2
+	SHRQ    $1, BX            // BX = floor( n / 2 )
+	JZ      dot_tail          // if BX == 0 { goto dot_tail }
+
+dot_loop: // do {
+	MOVUPS (SI)(AX*8), X4   // X_i = { imag(x[i]), real(x[i]) }
+	MOVUPS 16(SI)(AX*8), X6
+	MOVUPS (DI)(AX*8), X8   // X_j = { imag(y[i]), real(y[i]) }
+	MOVUPS 16(DI)(AX*8), X9
+
+	// X_(i+1) = { real(x[i]), real(x[i]) }
+	MOVDDUP_X4_X5
+	MOVDDUP_X6_X7
+
+	// X_i = { imag(x[i]), imag(x[i]) }
+	SHUFPD $0x3, X4, X4
+	SHUFPD $0x3, X6, X6
+
+	// X_(i+1) = { real(x[i]) * imag(y[i]), real(x[i]) * real(y[i]) }
+	// X_i     = { imag(x[i]) * imag(y[i]), imag(x[i]) * real(y[i]) }
+	MULPD X8, X5
+	MULPD X9, X7
+	MULPD X8, X4
+	MULPD X9, X6
+
+	// P += X_(i+1), Q += X_i
+	ADDPD X5, X0
+	ADDPD X7, X2
+	ADDPD X4, X1
+	ADDPD X6, X3
+
+	ADDQ $4, AX    // i += 2
+	DECQ BX
+	JNZ  dot_loop  // } while --BX > 0
+	ADDPD X2, X0   // Combine accumulators
+	ADDPD X3, X1
+	CMPQ CX, $0    // if CX == 0 { goto dot_end }
+	JE   dot_end
+
+dot_tail:
+	MOVUPS (SI)(AX*8), X4 // X4 = { imag(x[i]), real(x[i]) }
+	MOVUPS (DI)(AX*8), X8 // X8 = { imag(y[i]), real(y[i]) }
+	MOVDDUP_X4_X5         // X5 = { real(x[i]), real(x[i]) }
+	SHUFPD $0x3, X4, X4   // X4 = { imag(x[i]), imag(x[i]) }
+	MULPD  X8, X5         // X5 = { real(x[i]) * imag(y[i]), real(x[i]) * real(y[i]) }
+	MULPD  X8, X4         // X4 = { imag(x[i]) * imag(y[i]), imag(x[i]) * real(y[i]) }
+	ADDPD  X5, X0         // P += X5
+	ADDPD  X4, X1         // Q += X4
+
+dot_end:
+	// X0 = {
+	//	imag(sum): Σ real(x[i])*imag(y[i]) + Σ imag(x[i])*real(y[i]),
+	//	real(sum): Σ real(x[i])*real(y[i]) - Σ imag(x[i])*imag(y[i])
+	//  }
+	SHUFPD $0x1, X1, X1
+	ADDSUBPD_X1_X0
+	MOVUPS X0, sum+48(FP)
+	RET
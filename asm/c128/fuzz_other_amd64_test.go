@@ -0,0 +1,208 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18 && !noasm && !appengine
+// +build go1.18,!noasm,!appengine
+
+package c128
+
+import (
+	"math/cmplx"
+	"testing"
+
+	"github.com/gonum/internal/asm/internal/asmtest"
+)
+
+// The fuzz targets in this file cover the assembly kernels that are not
+// generated by asmgen. They use the helpers in fuzz_amd64_test.go.
+
+func FuzzMul(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, _, _, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		dst := newFuzzVector[complex128](s, int(n), 1, fuzzFront(off, 0))
+		x := newFuzzVector[complex128](s, int(n), 1, fuzzFront(off, 1))
+		dstr, xr := dst.Clone(), x.Clone()
+
+		Mul(dst.Slice(), x.Slice())
+
+		dv := dstr.Slice()
+		for i, v := range xr.Slice() {
+			dv[i] *= v
+		}
+		asmtest.CheckVector(t, "dst", dst, dstr)
+		asmtest.CheckVector(t, "s", x, xr)
+	})
+}
+
+func FuzzMulTo(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, _, _, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		x := newFuzzVector[complex128](s, int(n), 1, fuzzFront(off, 0))
+		y := newFuzzVector[complex128](s, int(n), 1, fuzzFront(off, 1))
+		dst := newFuzzVector[complex128](s, int(n), 1, fuzzFront(off, 2))
+		xr, yr, dstr := x.Clone(), y.Clone(), dst.Clone()
+
+		MulTo(dst.Slice(), x.Slice(), y.Slice())
+
+		yv, dv := yr.Slice(), dstr.Slice()
+		for i, v := range xr.Slice() {
+			dv[i] = v * yv[i]
+		}
+		asmtest.CheckVector(t, "x", x, xr)
+		asmtest.CheckVector(t, "y", y, yr)
+		asmtest.CheckVector(t, "dst", dst, dstr)
+	})
+}
+
+func FuzzMulConj(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, _, _, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		dst := newFuzzVector[complex128](s, int(n), 1, fuzzFront(off, 0))
+		x := newFuzzVector[complex128](s, int(n), 1, fuzzFront(off, 1))
+		dstr, xr := dst.Clone(), x.Clone()
+
+		MulConj(dst.Slice(), x.Slice())
+
+		dv := dstr.Slice()
+		for i, v := range xr.Slice() {
+			dv[i] *= cmplx.Conj(v)
+		}
+		asmtest.CheckVector(t, "dst", dst, dstr)
+		asmtest.CheckVector(t, "s", x, xr)
+	})
+}
+
+func FuzzMulConjTo(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, _, _, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		x := newFuzzVector[complex128](s, int(n), 1, fuzzFront(off, 0))
+		y := newFuzzVector[complex128](s, int(n), 1, fuzzFront(off, 1))
+		dst := newFuzzVector[complex128](s, int(n), 1, fuzzFront(off, 2))
+		xr, yr, dstr := x.Clone(), y.Clone(), dst.Clone()
+
+		MulConjTo(dst.Slice(), x.Slice(), y.Slice())
+
+		yv, dv := yr.Slice(), dstr.Slice()
+		for i, v := range xr.Slice() {
+			dv[i] = v * cmplx.Conj(yv[i])
+		}
+		asmtest.CheckVector(t, "x", x, xr)
+		asmtest.CheckVector(t, "y", y, yr)
+		asmtest.CheckVector(t, "dst", dst, dstr)
+	})
+}
+
+func FuzzDiv(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, _, _, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		dst := newFuzzVector[complex128](s, int(n), 1, fuzzFront(off, 0))
+		x := newFuzzVector[complex128](s, int(n), 1, fuzzFront(off, 1))
+		dstr, xr := dst.Clone(), x.Clone()
+
+		Div(dst.Slice(), x.Slice())
+
+		dv := dstr.Slice()
+		for i, v := range xr.Slice() {
+			dv[i] /= v
+		}
+		asmtest.CheckVector(t, "dst", dst, dstr)
+		asmtest.CheckVector(t, "s", x, xr)
+	})
+}
+
+func FuzzDivTo(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, _, _, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		x := newFuzzVector[complex128](s, int(n), 1, fuzzFront(off, 0))
+		y := newFuzzVector[complex128](s, int(n), 1, fuzzFront(off, 1))
+		dst := newFuzzVector[complex128](s, int(n), 1, fuzzFront(off, 2))
+		xr, yr, dstr := x.Clone(), y.Clone(), dst.Clone()
+
+		DivTo(dst.Slice(), x.Slice(), y.Slice())
+
+		yv, dv := yr.Slice(), dstr.Slice()
+		for i, v := range xr.Slice() {
+			dv[i] = v / yv[i]
+		}
+		asmtest.CheckVector(t, "x", x, xr)
+		asmtest.CheckVector(t, "y", y, yr)
+		asmtest.CheckVector(t, "dst", dst, dstr)
+	})
+}
+
+func FuzzAxpyConjUnitary(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, _, _, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		alpha := fuzzValue[complex128](s)
+		x := newFuzzVector[complex128](s, int(n), 1, fuzzFront(off, 0))
+		y := newFuzzVector[complex128](s, int(n), 1, fuzzFront(off, 1))
+		xr, yr := x.Clone(), y.Clone()
+
+		axpyConjUnitary(alpha, x.Slice(), y.Slice())
+
+		yv := yr.Slice()
+		for i, v := range xr.Slice() {
+			yv[i] += fuzzMul(alpha, cmplx.Conj(v))
+		}
+		asmtest.CheckVector(t, "x", x, xr)
+		asmtest.CheckVector(t, "y", y, yr)
+	})
+}
+
+func FuzzRotUnitary(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, _, _, _ int8, off uint8) {
+		src := &fuzzSource{data: data}
+		c := fuzzValue[float64](src)
+		s := fuzzValue[complex128](src)
+		x := newFuzzVector[complex128](src, int(n), 1, fuzzFront(off, 0))
+		y := newFuzzVector[complex128](src, int(n), 1, fuzzFront(off, 1))
+		xr, yr := x.Clone(), y.Clone()
+
+		RotUnitary(c, s, x.Slice(), y.Slice())
+
+		xv, yv := xr.Slice(), yr.Slice()
+		for i, vx := range xv {
+			vy := yv[i]
+			xv[i] = complex(c*real(vx), c*imag(vx)) + s*vy
+			yv[i] = complex(c*real(vy), c*imag(vy)) - cmplx.Conj(s)*vx
+		}
+		asmtest.CheckVector(t, "x", x, xr)
+		asmtest.CheckVector(t, "y", y, yr)
+	})
+}
+
+func FuzzRotInc(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, incX, incY, _ int8, off uint8) {
+		src := &fuzzSource{data: data}
+		c := fuzzValue[float64](src)
+		s := fuzzValue[complex128](src)
+		ln, incx, incy := int(n), fuzzInc(incX), fuzzInc(incY)
+		x := newFuzzVector[complex128](src, ln, incx, fuzzFront(off, 0))
+		y := newFuzzVector[complex128](src, ln, incy, fuzzFront(off, 1))
+		xr, yr := x.Clone(), y.Clone()
+		ix, iy := fuzzStart(ln, incx), fuzzStart(ln, incy)
+
+		RotInc(c, s, x.Slice(), y.Slice(), uintptr(ln), uintptr(incx), uintptr(incy), uintptr(ix), uintptr(iy))
+
+		xv, yv := xr.Slice(), yr.Slice()
+		for i := 0; i < ln; i++ {
+			vx, vy := xv[ix], yv[iy]
+			xv[ix] = complex(c*real(vx), c*imag(vx)) + s*vy
+			yv[iy] = complex(c*real(vy), c*imag(vy)) - cmplx.Conj(s)*vx
+			ix += incx
+			iy += incy
+		}
+		asmtest.CheckVector(t, "x", x, xr)
+		asmtest.CheckVector(t, "y", y, yr)
+	})
+}
@@ -0,0 +1,152 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package c128
+
+import (
+	"fmt"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+)
+
+// gemvVec returns a vector of n random elements with increment inc. The
+// elements between the strided positions are set to the sentinel value.
+func gemvVec(n, inc int, sentinel complex128, rnd *rand.Rand) []complex128 {
+	if inc < 0 {
+		inc = -inc
+	}
+	if n == 0 {
+		return nil
+	}
+	v := make([]complex128, (n-1)*inc+1)
+	for i := range v {
+		v[i] = sentinel
+	}
+	for i := 0; i < n; i++ {
+		v[i*inc] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+	}
+	return v
+}
+
+// vecIndex returns the position in a vector of length n with increment inc
+// that holds element i.
+func vecIndex(i, n, inc int) int {
+	if inc < 0 {
+		return (n - 1 - i) * -inc
+	}
+	return i * inc
+}
+
+// gemvRef computes y = alpha * op(A) * x + beta * y with op selected by
+// trans as 'N', 'T' or 'C', using a straightforward triple loop. A hermitian
+// A is evaluated when trans is 'H', reading only the upper triangle.
+func gemvRef(trans byte, m, n int, alpha complex128, a []complex128, lda int, x []complex128, incX int, beta complex128, y []complex128, incY int) {
+	lenX, lenY := n, m
+	if trans == 'T' || trans == 'C' {
+		lenX, lenY = m, n
+	}
+	for i := 0; i < lenY; i++ {
+		var sum complex128
+		for j := 0; j < lenX; j++ {
+			var aij complex128
+			switch trans {
+			case 'N':
+				aij = a[i*lda+j]
+			case 'T':
+				aij = a[j*lda+i]
+			case 'C':
+				aij = cmplx.Conj(a[j*lda+i])
+			case 'H':
+				switch {
+				case i < j:
+					aij = a[i*lda+j]
+				case i > j:
+					aij = cmplx.Conj(a[j*lda+i])
+				default:
+					aij = complex(real(a[i*lda+i]), 0)
+				}
+			}
+			sum += aij * x[vecIndex(j, lenX, incX)]
+		}
+		iy := vecIndex(i, lenY, incY)
+		if beta == 0 {
+			y[iy] = alpha * sum
+		} else {
+			y[iy] = alpha*sum + beta*y[iy]
+		}
+	}
+}
+
+func TestGemv(t *testing.T) {
+	const tol = 1e-13
+	sentinel := cmplx.NaN()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		name  string
+		trans byte
+		fn    func(m, n uintptr, alpha complex128, a []complex128, lda uintptr, x []complex128, incX uintptr, beta complex128, y []complex128, incY uintptr)
+	}{
+		{name: "GemvN", trans: 'N', fn: GemvN},
+		{name: "GemvT", trans: 'T', fn: GemvT},
+		{name: "GemvC", trans: 'C', fn: GemvC},
+		{name: "Hemv", trans: 'H', fn: func(m, n uintptr, alpha complex128, a []complex128, lda uintptr, x []complex128, incX uintptr, beta complex128, y []complex128, incY uintptr) {
+			Hemv(n, alpha, a, lda, x, incX, beta, y, incY)
+		}},
+	} {
+		for _, dims := range [][2]int{{0, 0}, {1, 1}, {1, 4}, {4, 1}, {3, 5}, {5, 3}, {7, 7}, {10, 13}} {
+			m, n := dims[0], dims[1]
+			if test.trans == 'H' {
+				m = n
+			}
+			lenX, lenY := n, m
+			if test.trans == 'T' || test.trans == 'C' {
+				lenX, lenY = m, n
+			}
+			for _, lda := range []int{n, n + 3} {
+				for _, inc := range []struct{ x, y int }{{1, 1}, {2, 3}, {-1, 1}, {1, -2}, {-3, -1}} {
+					for _, ab := range []struct{ alpha, beta complex128 }{{1, 0}, {0, 2i}, {1 - 2i, 1}, {0.5 + 1i, -1 + 0.5i}} {
+						prefix := fmt.Sprintf("%s m=%v n=%v lda=%v incX=%v incY=%v alpha=%v beta=%v",
+							test.name, m, n, lda, inc.x, inc.y, ab.alpha, ab.beta)
+						a := make([]complex128, m*lda)
+						for i := range a {
+							a[i] = sentinel
+						}
+						for i := 0; i < m; i++ {
+							for j := 0; j < n; j++ {
+								if test.trans != 'H' || j >= i {
+									a[i*lda+j] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+								}
+							}
+						}
+						x := gemvVec(lenX, inc.x, sentinel, rnd)
+						y := gemvVec(lenY, inc.y, sentinel, rnd)
+						if ab.beta == 0 {
+							for i := 0; i < lenY; i++ {
+								y[vecIndex(i, lenY, inc.y)] = cmplx.NaN()
+							}
+						}
+						want := make([]complex128, len(y))
+						copy(want, y)
+						gemvRef(test.trans, m, n, ab.alpha, a, lda, x, inc.x, ab.beta, want, inc.y)
+
+						test.fn(uintptr(m), uintptr(n), ab.alpha, a, uintptr(lda), x, uintptr(inc.x), ab.beta, y, uintptr(inc.y))
+
+						for i := range y {
+							if cmplx.IsNaN(want[i]) {
+								if !cmplx.IsNaN(y[i]) {
+									t.Errorf("%s: modified element %d outside of y", prefix, i)
+								}
+								continue
+							}
+							if !near(y[i], want[i], tol) {
+								t.Errorf("%s: unexpected result at %d: got %v want %v", prefix, i, y[i], want[i])
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
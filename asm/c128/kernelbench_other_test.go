@@ -0,0 +1,106 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18
+// +build go1.18
+
+package c128
+
+import "github.com/gonum/internal/asm/internal/asmtest"
+
+// otherBenchKernels are the benchmarks of the kernels that are not
+// generated by asmgen. The matrix-vector kernels are benchmarked with
+// four rows and n columns.
+var otherBenchKernels = []benchKernel{
+	{name: "Mul", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 6, Bytes: 3 * 16}, setup: func(n, _ int) func() {
+		dst := asmtest.BenchVector[complex128](n, 1)
+		x := asmtest.BenchVector[complex128](n, 1)
+		return func() {
+			Mul(dst, x)
+		}
+	}},
+	{name: "MulConj", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 6, Bytes: 3 * 16}, setup: func(n, _ int) func() {
+		dst := asmtest.BenchVector[complex128](n, 1)
+		x := asmtest.BenchVector[complex128](n, 1)
+		return func() {
+			MulConj(dst, x)
+		}
+	}},
+	{name: "MulTo", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 6, Bytes: 3 * 16}, setup: func(n, _ int) func() {
+		x := asmtest.BenchVector[complex128](n, 1)
+		y := asmtest.BenchVector[complex128](n, 1)
+		dst := asmtest.BenchVector[complex128](n, 1)
+		return func() {
+			MulTo(dst, x, y)
+		}
+	}},
+	{name: "MulConjTo", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 6, Bytes: 3 * 16}, setup: func(n, _ int) func() {
+		x := asmtest.BenchVector[complex128](n, 1)
+		y := asmtest.BenchVector[complex128](n, 1)
+		dst := asmtest.BenchVector[complex128](n, 1)
+		return func() {
+			MulConjTo(dst, x, y)
+		}
+	}},
+	{name: "Div", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 11, Bytes: 3 * 16}, setup: func(n, _ int) func() {
+		dst := asmtest.BenchVector[complex128](n, 1)
+		x := asmtest.BenchVector[complex128](n, 1)
+		return func() {
+			Div(dst, x)
+		}
+	}},
+	{name: "DivTo", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 11, Bytes: 3 * 16}, setup: func(n, _ int) func() {
+		x := asmtest.BenchVector[complex128](n, 1)
+		y := asmtest.BenchVector[complex128](n, 1)
+		dst := asmtest.BenchVector[complex128](n, 1)
+		return func() {
+			DivTo(dst, x, y)
+		}
+	}},
+	{name: "axpyConjUnitary", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 8, Bytes: 3 * 16}, setup: func(n, _ int) func() {
+		x := asmtest.BenchVector[complex128](n, 1)
+		y := asmtest.BenchVector[complex128](n, 1)
+		return func() {
+			axpyConjUnitary(1, x, y)
+		}
+	}},
+	{name: "RotUnitary", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 20, Bytes: 4 * 16}, setup: func(n, _ int) func() {
+		x := asmtest.BenchVector[complex128](n, 1)
+		y := asmtest.BenchVector[complex128](n, 1)
+		return func() {
+			RotUnitary(0.5, 1i, x, y)
+		}
+	}},
+	{name: "RotInc", incs: asmtest.BenchIncs, cost: asmtest.Cost{Flops: 20, Bytes: 4 * 16}, setup: func(n, inc int) func() {
+		x := asmtest.BenchVector[complex128](n, inc)
+		y := asmtest.BenchVector[complex128](n, -inc)
+		return func() {
+			RotInc(0.5, 1i, x, y, uintptr(n), uintptr(inc), uintptr(-inc), uintptr(asmtest.Start(n, inc)), uintptr(asmtest.Start(n, -inc)))
+		}
+	}},
+	{name: "GemvN", incs: asmtest.BenchIncs, cost: asmtest.Cost{Flops: 4 * 8, Bytes: 8 * 16}, setup: func(n, inc int) func() {
+		a := asmtest.BenchVector[complex128](4*n, 1)
+		x := asmtest.BenchVector[complex128](n, inc)
+		y := asmtest.BenchVector[complex128](4, 1)
+		return func() {
+			GemvN(4, uintptr(n), 1, a, uintptr(n), x, uintptr(inc), 1, y, 1)
+		}
+	}},
+	{name: "GemvT", incs: asmtest.BenchIncs, cost: asmtest.Cost{Flops: 4 * 8, Bytes: 12 * 16}, setup: func(n, inc int) func() {
+		a := asmtest.BenchVector[complex128](4*n, 1)
+		x := asmtest.BenchVector[complex128](4, 1)
+		y := asmtest.BenchVector[complex128](n, inc)
+		return func() {
+			GemvT(4, uintptr(n), 1, a, uintptr(n), x, 1, 1, y, uintptr(inc))
+		}
+	}},
+	{name: "GemvC", incs: asmtest.BenchIncs, cost: asmtest.Cost{Flops: 4 * 8, Bytes: 12 * 16}, setup: func(n, inc int) func() {
+		a := asmtest.BenchVector[complex128](4*n, 1)
+		x := asmtest.BenchVector[complex128](4, 1)
+		y := asmtest.BenchVector[complex128](n, inc)
+		return func() {
+			GemvC(4, uintptr(n), 1, a, uintptr(n), x, 1, 1, y, uintptr(inc))
+		}
+	}},
+}
@@ -0,0 +1,137 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package c128
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+)
+
+var (
+	inf = math.Inf(1)
+	nan = math.NaN()
+)
+
+// specials holds values that exercise the Inf, NaN, zero and
+// over/underflow handling of the elementwise kernels.
+var specials = []complex128{
+	0, complex(math.Copysign(0, -1), 0), 1, -1i, 1 + 1i,
+	complex(inf, 0), complex(0, -inf), complex(inf, inf), complex(-inf, 1),
+	complex(nan, 0), complex(1, nan), complex(nan, nan), complex(inf, nan),
+	complex(math.MaxFloat64, math.MaxFloat64), complex(math.SmallestNonzeroFloat64, 1),
+	complex(1e300, -1e-300), complex(-1e-300, 1e300),
+}
+
+// sameFloat reports whether a and b are bitwise equal ignoring NaN payloads.
+func sameFloat(a, b float64) bool {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return math.IsNaN(a) && math.IsNaN(b)
+	}
+	return a == b && math.Signbit(a) == math.Signbit(b)
+}
+
+// sameCmplx reports whether the parts of a and b are bitwise equal
+// ignoring NaN payloads.
+func sameCmplx(a, b complex128) bool {
+	return sameFloat(real(a), real(b)) && sameFloat(imag(a), imag(b))
+}
+
+// elemVectors returns a pair of vectors of length n holding random values
+// with widely spread magnitudes, mixed with special values.
+func elemVectors(n int, rnd *rand.Rand) (x, y []complex128) {
+	x, y = make([]complex128, n), make([]complex128, n)
+	for i := range x {
+		x[i] = complex(rnd.NormFloat64()*math.Pow(10, float64(rnd.Intn(40)-20)), rnd.NormFloat64())
+		y[i] = complex(rnd.NormFloat64(), rnd.NormFloat64()*math.Pow(10, float64(rnd.Intn(40)-20)))
+		if rnd.Intn(4) == 0 {
+			x[i] = specials[rnd.Intn(len(specials))]
+		}
+		if rnd.Intn(4) == 0 {
+			y[i] = specials[rnd.Intn(len(specials))]
+		}
+	}
+	return x, y
+}
+
+func TestElemSpecials(t *testing.T) {
+	var x, y []complex128
+	for _, a := range specials {
+		for _, b := range specials {
+			x = append(x, a)
+			y = append(y, b)
+		}
+	}
+	testElem(t, "specials", x, y)
+}
+
+func TestElemRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for n := 0; n < 40; n++ {
+		x, y := elemVectors(n, rnd)
+		testElem(t, "random", x, y)
+	}
+}
+
+func testElem(t *testing.T, name string, x, y []complex128) {
+	const gdLn = 4
+	var gdVal complex128 = 1 + 1i
+	for _, test := range []struct {
+		fn   string
+		op   func(a, b complex128) complex128
+		to   func(dst, x, y []complex128) []complex128
+		inPl func(dst, s []complex128)
+	}{
+		{
+			fn: "Mul",
+			op: func(a, b complex128) complex128 { return a * b },
+			to: MulTo, inPl: Mul,
+		},
+		{
+			fn: "MulConj",
+			op: func(a, b complex128) complex128 { return a * cmplx.Conj(b) },
+			to: MulConjTo, inPl: MulConj,
+		},
+		{
+			fn: "Div",
+			op: func(a, b complex128) complex128 { return a / b },
+			to: DivTo, inPl: Div,
+		},
+	} {
+		for _, align := range []int{0, 1} {
+			xg, yg := guardVector(x, gdVal, gdLn+align), guardVector(y, gdVal, gdLn)
+			dg := guardVector(make([]complex128, len(x)), gdVal, gdLn+1-align)
+			xs, ys := xg[gdLn+align:len(xg)-gdLn-align], yg[gdLn:len(yg)-gdLn]
+			dst := dg[gdLn+1-align : len(dg)-gdLn-1+align]
+
+			test.to(dst, xs, ys)
+			for i := range x {
+				want := test.op(x[i], y[i])
+				if !sameCmplx(dst[i], want) {
+					t.Errorf("%s %sTo(%v, %v) at %d: got %v want %v", name, test.fn, x[i], y[i], i, dst[i], want)
+				}
+			}
+			if !isValidGuard(dg, gdVal, gdLn+1-align) {
+				t.Errorf("%s %sTo: guard violated in dst vector", name, test.fn)
+			}
+
+			copy(dst, xs)
+			test.inPl(dst, ys)
+			for i := range x {
+				want := test.op(x[i], y[i])
+				if !sameCmplx(dst[i], want) {
+					t.Errorf("%s %s(%v, %v) at %d: got %v want %v", name, test.fn, x[i], y[i], i, dst[i], want)
+				}
+			}
+			if !isValidGuard(dg, gdVal, gdLn+1-align) {
+				t.Errorf("%s %s: guard violated in dst vector", name, test.fn)
+			}
+			if !isValidGuard(xg, gdVal, gdLn+align) || !isValidGuard(yg, gdVal, gdLn) {
+				t.Errorf("%s %s: guard violated in source vector", name, test.fn)
+			}
+		}
+	}
+}
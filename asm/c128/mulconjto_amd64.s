@@ -0,0 +1,102 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+#include "textflag.h"
+
+// MOVDDUP X0, X2
+#define MOVDDUP_X0_X2 BYTE $0xF2; BYTE $0x0F; BYTE $0x12; BYTE $0xD0
+// MOVDDUP X4, X6
+#define MOVDDUP_X4_X6 BYTE $0xF2; BYTE $0x0F; BYTE $0x12; BYTE $0xF4
+
+// ADDSUBPD X0, X2
+#define ADDSUBPD_X0_X2 BYTE $0x66; BYTE $0x0F; BYTE $0xD0; BYTE $0xD0
+// ADDSUBPD X4, X6
+#define ADDSUBPD_X4_X6 BYTE $0x66; BYTE $0x0F; BYTE $0xD0; BYTE $0xF4
+
+// func MulConjTo(dst, x, y []complex128) []complex128
+TEXT ·MulConjTo(SB), NOSPLIT, $0
+	MOVQ    dst_base+0(FP), DI // DI = &dst
+	MOVQ    dst_len+8(FP), CX  // CX = min( len(dst), len(x), len(y) )
+	MOVQ    x_base+24(FP), SI  // SI = &x
+	MOVQ    y_base+48(FP), DX  // DX = &y
+	CMPQ    x_len+32(FP), CX
+	CMOVQLE x_len+32(FP), CX
+	CMPQ    y_len+56(FP), CX
+	CMOVQLE y_len+56(FP), CX
+	MOVQ    CX, ret_len+80(FP) // len(ret) = CX
+	CMPQ    CX, $0             // if CX == 0 { return }
+	JE      mul_end
+	PCMPEQL X8, X8             // X8 = { -0, 0 }
+	PSLLQ   $63, X8
+	PSLLO   $8, X8
+	XORQ    AX, AX             // i = 0
+	MOVQ    CX, BX
+	ANDQ    $1, CX             // CX = n % 2
+	SHRQ    $1, BX             // BX = floor( n / 2 )
+	JZ      mul_tail           // if BX == 0 { goto mul_tail }
+
+mul_loop: // do {
+	MOVUPS (SI)(AX*8), X0   // X_i = { imag(x[i]), real(x[i]) }
+	MOVUPS 16(SI)(AX*8), X4
+	MOVUPS (DX)(AX*8), X1   // X_(i+1) = { imag(y[i]), real(y[i]) }
+	MOVUPS 16(DX)(AX*8), X5
+	XORPD  X8, X1           // X_(i+1) = { -imag(y[i]), real(y[i]) }
+	XORPD  X8, X5
+
+	// X_(i+2) = { real(x[i]), real(x[i]) }
+	MOVDDUP_X0_X2
+	MOVDDUP_X4_X6
+
+	// X_i = { imag(x[i]), imag(x[i]) }
+	SHUFPD $0x3, X0, X0
+	SHUFPD $0x3, X4, X4
+
+	// X_(i+3) = { real(y[i]), -imag(y[i]) }
+	MOVAPS X1, X3
+	MOVAPS X5, X7
+	SHUFPD $0x1, X3, X3
+	SHUFPD $0x1, X7, X7
+
+	// X_(i+2) = { real(x[i]) * -imag(y[i]), real(x[i]) * real(y[i]) }
+	// X_i     = { imag(x[i]) * real(y[i]), imag(x[i]) * -imag(y[i]) }
+	MULPD X1, X2
+	MULPD X5, X6
+	MULPD X3, X0
+	MULPD X7, X4
+
+	// X_(i+2) = {
+	//	imag(result[i]):  real(x[i])*-imag(y[i]) + imag(x[i])*real(y[i]),
+	//	real(result[i]):  real(x[i])*real(y[i]) - imag(x[i])*-imag(y[i])
+	//  }
+	ADDSUBPD_X0_X2
+	ADDSUBPD_X4_X6
+
+	MOVUPS X2, (DI)(AX*8)   // dst[i] = X_(i+2)
+	MOVUPS X6, 16(DI)(AX*8)
+	ADDQ   $4, AX           // i += 2
+	DECQ   BX
+	JNZ    mul_loop         // } while --BX > 0
+	CMPQ   CX, $0           // if CX == 0 { return }
+	JE     mul_end
+
+mul_tail:
+	MOVUPS (SI)(AX*8), X0 // X0 = { imag(x[i]), real(x[i]) }
+	MOVUPS (DX)(AX*8), X1 // X1 = { imag(y[i]), real(y[i]) }
+	XORPD  X8, X1         // X1 = { -imag(y[i]), real(y[i]) }
+	MOVDDUP_X0_X2         // X2 = { real(x[i]), real(x[i]) }
+	SHUFPD $0x3, X0, X0   // X0 = { imag(x[i]), imag(x[i]) }
+	MOVAPS X1, X3
+	SHUFPD $0x1, X3, X3   // X3 = { real(y[i]), -imag(y[i]) }
+	MULPD  X1, X2         // X2 = { real(x[i]) * -imag(y[i]), real(x[i]) * real(y[i]) }
+	MULPD  X3, X0         // X0 = { imag(x[i]) * real(y[i]), imag(x[i]) * -imag(y[i]) }
+	ADDSUBPD_X0_X2        // X2 = x[i] * conj(y[i])
+	MOVUPS X2, (DI)(AX*8) // dst[i] = X2
+
+mul_end:
+	MOVQ DI, ret_base+72(FP) // &ret = &dst
+	MOVQ dst_cap+16(FP), DI  // cap(ret) = cap(dst)
+	MOVQ DI, ret_cap+88(FP)
+	RET
@@ -0,0 +1,142 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package c128
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+)
+
+func TestRot(t *testing.T) {
+	var gdVal complex128 = 1 + 1i
+	rnd := rand.New(rand.NewSource(1))
+	for _, cs := range []struct {
+		c float64
+		s complex128
+	}{
+		{c: 1, s: 0},
+		{c: 0, s: 1i},
+		{c: 0.6, s: 0.48 + 0.64i},
+		{c: math.Sqrt(0.5), s: complex(-0.5, 0.5)},
+	} {
+		for n := 0; n < 10; n++ {
+			x, y := elemVectors(n, rnd)
+			wantX, wantY := make([]complex128, n), make([]complex128, n)
+			for i := range x {
+				wantX[i] = complex(cs.c*real(x[i]), cs.c*imag(x[i])) + cs.s*y[i]
+				wantY[i] = complex(cs.c*real(y[i]), cs.c*imag(y[i])) - cmplx.Conj(cs.s)*x[i]
+			}
+
+			for _, align := range []int{0, 1} {
+				prefix := fmt.Sprintf("RotUnitary c=%v s=%v n=%v align=%v", cs.c, cs.s, n, align)
+				xg, yg := guardVector(x, gdVal, 4+align), guardVector(y, gdVal, 4)
+				xs, ys := xg[4+align:len(xg)-4-align], yg[4:len(yg)-4]
+				RotUnitary(cs.c, cs.s, xs, ys)
+				for i := range wantX {
+					if !sameCmplx(xs[i], wantX[i]) || !sameCmplx(ys[i], wantY[i]) {
+						t.Errorf("%v: unexpected result at %d: got (%v, %v) want (%v, %v)",
+							prefix, i, xs[i], ys[i], wantX[i], wantY[i])
+					}
+				}
+				if !isValidGuard(xg, gdVal, 4+align) || !isValidGuard(yg, gdVal, 4) {
+					t.Errorf("%v: guard violated", prefix)
+				}
+			}
+
+			for _, inc := range []struct{ x, y int }{{1, 1}, {2, 3}, {-1, 2}, {3, -2}} {
+				prefix := fmt.Sprintf("RotInc c=%v s=%v n=%v incX=%v incY=%v", cs.c, cs.s, n, inc.x, inc.y)
+				xg := guardIncVector(x, gdVal, uintptr(inc.x), 4)
+				yg := guardIncVector(y, gdVal, uintptr(inc.y), 4)
+				xs, ys := xg[4:len(xg)-4], yg[4:len(yg)-4]
+				var ix, iy int
+				if inc.x < 0 {
+					ix = (1 - n) * inc.x
+				}
+				if inc.y < 0 {
+					iy = (1 - n) * inc.y
+				}
+				wantXs := append([]complex128(nil), xs...)
+				wantYs := append([]complex128(nil), ys...)
+				for i, jx, jy := 0, ix, iy; i < n; i++ {
+					vx, vy := wantXs[jx], wantYs[jy]
+					wantXs[jx] = complex(cs.c*real(vx), cs.c*imag(vx)) + cs.s*vy
+					wantYs[jy] = complex(cs.c*real(vy), cs.c*imag(vy)) - cmplx.Conj(cs.s)*vx
+					jx += inc.x
+					jy += inc.y
+				}
+				RotInc(cs.c, cs.s, xs, ys, uintptr(n), uintptr(inc.x), uintptr(inc.y), uintptr(ix), uintptr(iy))
+				for i := 0; i < n; i++ {
+					jx, jy := ix+i*inc.x, iy+i*inc.y
+					if !sameCmplx(xs[jx], wantXs[jx]) || !sameCmplx(ys[jy], wantYs[jy]) {
+						t.Errorf("%v: unexpected result at %d: got (%v, %v) want (%v, %v)",
+							prefix, i, xs[jx], ys[jy], wantXs[jx], wantYs[jy])
+					}
+				}
+				checkValidIncGuard(t, xg, gdVal, uintptr(inc.x), 4)
+				checkValidIncGuard(t, yg, gdVal, uintptr(inc.y), 4)
+			}
+		}
+	}
+}
+
+func TestRotg(t *testing.T) {
+	const tol = 1e-14
+	rnd := rand.New(rand.NewSource(1))
+	var cases [][2]complex128
+	for _, scale := range []float64{1, 1e-300, 1e300, 1e-160, 1e160} {
+		for i := 0; i < 10; i++ {
+			cases = append(cases, [2]complex128{
+				complex(rnd.NormFloat64()*scale, rnd.NormFloat64()*scale),
+				complex(rnd.NormFloat64(), rnd.NormFloat64()),
+			}, [2]complex128{
+				complex(rnd.NormFloat64(), rnd.NormFloat64()),
+				complex(rnd.NormFloat64()*scale, rnd.NormFloat64()*scale),
+			})
+		}
+	}
+	cases = append(cases,
+		[2]complex128{0, 0}, [2]complex128{1 + 2i, 0}, [2]complex128{0, 3 - 4i},
+		[2]complex128{0, 5i}, [2]complex128{0, -2}, [2]complex128{0, 1e300 + 1e300i},
+		[2]complex128{1e-310, 1e300}, [2]complex128{1e300i, 1e-310},
+		[2]complex128{1e308, 1e308i}, [2]complex128{-1e308 + 1e308i, 1},
+	)
+	for _, fg := range cases {
+		f, g := fg[0], fg[1]
+		c, s, r := Rotg(f, g)
+		prefix := fmt.Sprintf("f=%v g=%v", f, g)
+		if c < 0 || c > 1 || math.IsNaN(c) {
+			t.Errorf("%v: unexpected c=%v", prefix, c)
+		}
+		if cmplx.IsNaN(s) || cmplx.IsInf(s) || cmplx.IsNaN(r) || cmplx.IsInf(r) {
+			t.Errorf("%v: non-finite result s=%v r=%v", prefix, s, r)
+			continue
+		}
+		if d := math.Abs(c*c + absSq(s) - 1); d > tol {
+			t.Errorf("%v: rotation not unitary: c^2+|s|^2-1=%v", prefix, d)
+		}
+		// Check the rotated vector with f and g scaled to unit size
+		// so the residuals can be tested without overflow.
+		scale := math.Max(cmplx.Abs(f), cmplx.Abs(g))
+		if scale == 0 {
+			if c != 1 || s != 0 || r != 0 {
+				t.Errorf("%v: unexpected result c=%v s=%v r=%v", prefix, c, s, r)
+			}
+			continue
+		}
+		fs, gs, rs := divReal(f, scale), divReal(g, scale), divReal(r, scale)
+		if d := cmplx.Abs(mulReal(fs, c) + s*gs - rs); d > tol {
+			t.Errorf("%v: |c*f + s*g - r| = %v", prefix, d)
+		}
+		if d := cmplx.Abs(mulReal(gs, c) - cmplx.Conj(s)*fs); d > tol {
+			t.Errorf("%v: |c*g - conj(s)*f| = %v", prefix, d)
+		}
+		if f == 0 && imag(r) != 0 {
+			t.Errorf("%v: r not real for zero f: %v", prefix, r)
+		}
+	}
+}
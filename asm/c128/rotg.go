@@ -0,0 +1,129 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package c128
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+const (
+	safmin = 2.2250738585072014e-308 // Smallest normalized float64.
+	safmax = 1 / safmin
+)
+
+// Rotg computes the plane rotation
+//  [  c         s ] [ f ]   [ r ]
+//  [ -conj(s)   c ] [ g ] = [ 0 ]
+// with real cosine c ≥ 0 and complex sine s, such that c^2 + |s|^2 = 1.
+// It follows the scaling strategy of LAPACK's zlartg so that intermediate
+// results neither overflow nor underflow unnecessarily. If g is zero then
+// c = 1, s = 0 and r = f. If f is zero then c = 0 and r is real.
+func Rotg(f, g complex128) (c float64, s, r complex128) {
+	rtmin := math.Sqrt(safmin)
+	switch {
+	case g == 0:
+		return 1, 0, f
+	case f == 0:
+		if real(g) == 0 || imag(g) == 0 {
+			d := cmplx.Abs(g)
+			return 0, divReal(cmplx.Conj(g), d), complex(d, 0)
+		}
+		g1 := math.Max(math.Abs(real(g)), math.Abs(imag(g)))
+		rtmax := math.Sqrt(safmax / 2)
+		if rtmin < g1 && g1 < rtmax {
+			// Use unscaled algorithm.
+			d := math.Sqrt(absSq(g))
+			return 0, divReal(cmplx.Conj(g), d), complex(d, 0)
+		}
+		// Use scaled algorithm.
+		u := math.Min(safmax, math.Max(safmin, g1))
+		gs := divReal(g, u)
+		d := math.Sqrt(absSq(gs))
+		return 0, divReal(cmplx.Conj(gs), d), complex(d*u, 0)
+	}
+
+	f1 := math.Max(math.Abs(real(f)), math.Abs(imag(f)))
+	g1 := math.Max(math.Abs(real(g)), math.Abs(imag(g)))
+	rtmax := math.Sqrt(safmax / 4)
+	if rtmin < f1 && f1 < rtmax && rtmin < g1 && g1 < rtmax {
+		// Use unscaled algorithm.
+		f2 := absSq(f)
+		h2 := f2 + absSq(g)
+		c, s, r = rotgScaled(f, g, f2, h2, rtmin, rtmax)
+		return c, s, r
+	}
+
+	// Use scaled algorithm.
+	u := math.Min(safmax, math.Max(safmin, math.Max(f1, g1)))
+	gs := divReal(g, u)
+	g2 := absSq(gs)
+	var (
+		w      float64
+		fs     complex128
+		f2, h2 float64
+	)
+	if f1/u < rtmin {
+		// f is not well-scaled when scaled by g1, so
+		// use a different scaling for f.
+		v := math.Min(safmax, math.Max(safmin, f1))
+		w = v / u
+		fs = divReal(f, v)
+		f2 = absSq(fs)
+		h2 = f2*w*w + g2
+	} else {
+		// Otherwise use the same scaling for f and g.
+		w = 1
+		fs = divReal(f, u)
+		f2 = absSq(fs)
+		h2 = f2 + g2
+	}
+	c, s, r = rotgScaled(fs, gs, f2, h2, rtmin, rtmax)
+	return c * w, s, complex(real(r)*u, imag(r)*u)
+}
+
+// rotgScaled computes the rotation for f and g where f2 = |f|^2 and
+// h2 = |f|^2 + |g|^2 satisfy safmin ≤ f2 ≤ h2 ≤ safmax.
+func rotgScaled(f, g complex128, f2, h2, rtmin, rtmax float64) (c float64, s, r complex128) {
+	if f2 >= h2*safmin {
+		// safmin ≤ f2/h2 ≤ 1, and h2/f2 is finite.
+		c = math.Sqrt(f2 / h2)
+		r = divReal(f, c)
+		rtmax *= 2
+		if f2 > rtmin && h2 < rtmax {
+			// safmin ≤ sqrt(f2*h2) ≤ safmax.
+			s = cmplx.Conj(g) * divReal(f, math.Sqrt(f2*h2))
+		} else {
+			s = cmplx.Conj(g) * divReal(r, h2)
+		}
+		return c, s, r
+	}
+	// f2/h2 ≤ safmin may be subnormal, and h2/f2 may overflow.
+	d := math.Sqrt(f2 * h2)
+	c = f2 / d
+	if c >= safmin {
+		r = divReal(f, c)
+	} else {
+		// f2/sqrt(f2*h2) < safmin, so scale f by h2/d instead.
+		r = mulReal(f, h2/d)
+	}
+	s = cmplx.Conj(g) * divReal(f, d)
+	return c, s, r
+}
+
+// absSq returns |z|^2.
+func absSq(z complex128) float64 {
+	return real(z)*real(z) + imag(z)*imag(z)
+}
+
+// divReal returns z/a for real a.
+func divReal(z complex128, a float64) complex128 {
+	return complex(real(z)/a, imag(z)/a)
+}
+
+// mulReal returns z*a for real a.
+func mulReal(z complex128, a float64) complex128 {
+	return complex(real(z)*a, imag(z)*a)
+}
@@ -34,3 +34,73 @@ func AxpyInc(alpha complex128, x, y []complex128, n, incX, incY, ix, iy uintptr)
 //  	idst += incDst
 //  }
 func AxpyIncTo(dst []complex128, incDst, idst uintptr, alpha complex128, x, y []complex128, n, incX, incY, ix, iy uintptr)
+
+// Mul is
+//  for i, v := range s {
+//  	dst[i] *= v
+//  }
+func Mul(dst, s []complex128)
+
+// MulTo is
+//  for i, v := range x {
+//  	dst[i] = v * y[i]
+//  }
+//  return dst
+func MulTo(dst, x, y []complex128) []complex128
+
+// MulConj is
+//  for i, v := range s {
+//  	dst[i] *= cmplx.Conj(v)
+//  }
+func MulConj(dst, s []complex128)
+
+// MulConjTo is
+//  for i, v := range x {
+//  	dst[i] = v * cmplx.Conj(y[i])
+//  }
+//  return dst
+func MulConjTo(dst, x, y []complex128) []complex128
+
+// divTo computes dst[i] = x[i] / y[i] using Smith's algorithm, as
+// the Go runtime does, and returns the number of elements processed.
+// It stops at the first element where either operand is not finite
+// or y[i] is zero, leaving that element for the caller.
+func divTo(dst, x, y []complex128) int
+
+// DotuUnitary is
+//  for i, v := range x {
+//  	sum += y[i] * v
+//  }
+//  return sum
+func DotuUnitary(x, y []complex128) (sum complex128)
+
+// DotcUnitary is
+//  for i, v := range x {
+//  	sum += y[i] * cmplx.Conj(v)
+//  }
+//  return sum
+func DotcUnitary(x, y []complex128) (sum complex128)
+
+// axpyConjUnitary is
+//  for i, v := range x {
+//  	y[i] += alpha * cmplx.Conj(v)
+//  }
+func axpyConjUnitary(alpha complex128, x, y []complex128)
+
+// RotUnitary is
+//  for i, vx := range x {
+//  	vy := y[i]
+//  	x[i] = complex(c*real(vx), c*imag(vx)) + s*vy
+//  	y[i] = complex(c*real(vy), c*imag(vy)) - cmplx.Conj(s)*vx
+//  }
+func RotUnitary(c float64, s complex128, x, y []complex128)
+
+// RotInc is
+//  for i := 0; i < int(n); i++ {
+//  	vx, vy := x[ix], y[iy]
+//  	x[ix] = complex(c*real(vx), c*imag(vx)) + s*vy
+//  	y[iy] = complex(c*real(vy), c*imag(vy)) - cmplx.Conj(s)*vx
+//  	ix += incX
+//  	iy += incY
+//  }
+func RotInc(c float64, s complex128, x, y []complex128, n, incX, incY, ix, iy uintptr)
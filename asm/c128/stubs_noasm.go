@@ -6,6 +6,8 @@
 
 package c128
 
+import "math/cmplx"
+
 // AxpyUnitary is
 //  for i, v := range x {
 //  	y[i] += alpha * v
@@ -55,3 +57,135 @@ func AxpyIncTo(dst []complex128, incDst, idst uintptr, alpha complex128, x, y []
 		idst += incDst
 	}
 }
+
+// Mul is
+//  for i, v := range s {
+//  	dst[i] *= v
+//  }
+func Mul(dst, s []complex128) {
+	for i, v := range s {
+		dst[i] *= v
+	}
+}
+
+// MulTo is
+//  for i, v := range x {
+//  	dst[i] = v * y[i]
+//  }
+//  return dst
+func MulTo(dst, x, y []complex128) []complex128 {
+	for i, v := range x {
+		dst[i] = v * y[i]
+	}
+	return dst
+}
+
+// MulConj is
+//  for i, v := range s {
+//  	dst[i] *= cmplx.Conj(v)
+//  }
+func MulConj(dst, s []complex128) {
+	for i, v := range s {
+		dst[i] *= cmplx.Conj(v)
+	}
+}
+
+// MulConjTo is
+//  for i, v := range x {
+//  	dst[i] = v * cmplx.Conj(y[i])
+//  }
+//  return dst
+func MulConjTo(dst, x, y []complex128) []complex128 {
+	for i, v := range x {
+		dst[i] = v * cmplx.Conj(y[i])
+	}
+	return dst
+}
+
+// Div is
+//  for i, v := range s {
+//  	dst[i] /= v
+//  }
+func Div(dst, s []complex128) {
+	for i, v := range s {
+		dst[i] /= v
+	}
+}
+
+// DivTo is
+//  for i, v := range x {
+//  	dst[i] = v / y[i]
+//  }
+//  return dst
+func DivTo(dst, x, y []complex128) []complex128 {
+	for i, v := range x {
+		dst[i] = v / y[i]
+	}
+	return dst
+}
+
+// DotuUnitary is
+//  for i, v := range x {
+//  	sum += y[i] * v
+//  }
+//  return sum
+func DotuUnitary(x, y []complex128) (sum complex128) {
+	for i, v := range x {
+		sum += y[i] * v
+	}
+	return sum
+}
+
+// DotcUnitary is
+//  for i, v := range x {
+//  	sum += y[i] * cmplx.Conj(v)
+//  }
+//  return sum
+func DotcUnitary(x, y []complex128) (sum complex128) {
+	for i, v := range x {
+		sum += y[i] * cmplx.Conj(v)
+	}
+	return sum
+}
+
+// axpyConjUnitary is
+//  for i, v := range x {
+//  	y[i] += alpha * cmplx.Conj(v)
+//  }
+func axpyConjUnitary(alpha complex128, x, y []complex128) {
+	for i, v := range x {
+		y[i] += alpha * cmplx.Conj(v)
+	}
+}
+
+// RotUnitary is
+//  for i, vx := range x {
+//  	vy := y[i]
+//  	x[i] = complex(c*real(vx), c*imag(vx)) + s*vy
+//  	y[i] = complex(c*real(vy), c*imag(vy)) - cmplx.Conj(s)*vx
+//  }
+func RotUnitary(c float64, s complex128, x, y []complex128) {
+	for i, vx := range x {
+		vy := y[i]
+		x[i] = complex(c*real(vx), c*imag(vx)) + s*vy
+		y[i] = complex(c*real(vy), c*imag(vy)) - cmplx.Conj(s)*vx
+	}
+}
+
+// RotInc is
+//  for i := 0; i < int(n); i++ {
+//  	vx, vy := x[ix], y[iy]
+//  	x[ix] = complex(c*real(vx), c*imag(vx)) + s*vy
+//  	y[iy] = complex(c*real(vy), c*imag(vy)) - cmplx.Conj(s)*vx
+//  	ix += incX
+//  	iy += incY
+//  }
+func RotInc(c float64, s complex128, x, y []complex128, n, incX, incY, ix, iy uintptr) {
+	for i := 0; i < int(n); i++ {
+		vx, vy := x[ix], y[iy]
+		x[ix] = complex(c*real(vx), c*imag(vx)) + s*vy
+		y[iy] = complex(c*real(vy), c*imag(vy)) - cmplx.Conj(s)*vx
+		ix += incX
+		iy += incY
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+#include "textflag.h"
+
+// MOVSHDUP X3, X2
+#define MOVSHDUP_X3_X2 BYTE $0xF3; BYTE $0x0F; BYTE $0x16; BYTE $0xD3
+// MOVSLDUP X3, X3
+#define MOVSLDUP_X3_X3 BYTE $0xF3; BYTE $0x0F; BYTE $0x12; BYTE $0xDB
+// ADDSUBPS X2, X3
+#define ADDSUBPS_X2_X3 BYTE $0xF2; BYTE $0x0F; BYTE $0xD0; BYTE $0xDA
+
+// func axpyConjUnitary(alpha complex64, x, y []complex64)
+TEXT ·axpyConjUnitary(SB), NOSPLIT, $0
+	MOVQ    x_base+8(FP), SI  // SI = &x
+	MOVQ    y_base+32(FP), DI // DI = &y
+	MOVQ    x_len+16(FP), CX  // CX = min( len(x), len(y) )
+	CMPQ    y_len+40(FP), CX
+	CMOVQLE y_len+40(FP), CX
+	CMPQ    CX, $0            // if CX == 0 { return }
+	JE      caxyc_end
+	MOVSD   alpha+0(FP), X0   // X0 = { 0, 0, imag(a), real(a) }
+	SHUFPD  $0, X0, X0        // X0 = { imag(a), real(a), imag(a), real(a) }
+	MOVAPS  X0, X1
+	SHUFPS  $0x11, X1, X1     // X1 = { real(a), imag(a), real(a), imag(a) }
+	PCMPEQL X8, X8            // X8 = { -0, 0, -0, 0 }
+	PSLLQ   $63, X8
+	XORQ    AX, AX            // i = 0
+	MOVQ    CX, BX
+	ANDQ    $1, CX            // CX = n % 2
+	SHRQ    $1, BX            // BX = floor( n / 2 )
+	JZ      caxyc_tail        // if BX == 0 { goto caxyc_tail }
+
+caxyc_loop: // do {
+	MOVUPS (SI)(AX*8), X3 // X3 = { imag(x[i+1]), real(x[i+1]), imag(x[i]), real(x[i]) }
+	XORPS  X8, X3         // X3 = conj(X3)
+	MOVSHDUP_X3_X2        // X2 = { -imag(x[i+1]), -imag(x[i+1]), -imag(x[i]), -imag(x[i]) }
+	MOVSLDUP_X3_X3        // X3 = { real(x[i+1]), real(x[i+1]), real(x[i]), real(x[i]) }
+
+	// X2 = { ..., real(a) * -imag(x[i]), imag(a) * -imag(x[i]) }
+	// X3 = { ..., imag(a) * real(x[i]), real(a) * real(x[i]) }
+	MULPS X1, X2
+	MULPS X0, X3
+
+	// X3 = { ...,
+	//	imag(result[i]):  imag(a)*real(x[i]) - real(a)*imag(x[i]),
+	//	real(result[i]):  real(a)*real(x[i]) + imag(a)*imag(x[i])
+	//  }
+	ADDSUBPS_X2_X3
+
+	MOVUPS (DI)(AX*8), X4 // X3 += y[i:i+1]
+	ADDPS  X4, X3
+	MOVUPS X3, (DI)(AX*8) // y[i:i+1] = X3
+	ADDQ   $2, AX         // i += 2
+	DECQ   BX
+	JNZ    caxyc_loop     // } while --BX > 0
+	CMPQ   CX, $0         // if CX == 0 { return }
+	JE     caxyc_end
+
+caxyc_tail:
+	MOVSD (SI)(AX*8), X3 // X3 = { imag(x[i]), real(x[i]) }
+	XORPS X8, X3         // X3 = { -imag(x[i]), real(x[i]) }
+	MOVSHDUP_X3_X2       // X2 = { -imag(x[i]), -imag(x[i]) }
+	MOVSLDUP_X3_X3       // X3 = { real(x[i]), real(x[i]) }
+	MULPS X1, X2         // X2 = { real(a) * -imag(x[i]), imag(a) * -imag(x[i]) }
+	MULPS X0, X3         // X3 = { imag(a) * real(x[i]), real(a) * real(x[i]) }
+	ADDSUBPS_X2_X3       // X3 = a * conj(x[i])
+	MOVSD (DI)(AX*8), X4 // X3 += y[i]
+	ADDPS X4, X3
+	MOVSD X3, (DI)(AX*8) // y[i] = X3
+
+caxyc_end:
+	RET
@@ -0,0 +1,55 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+#include "textflag.h"
+
+// func Complex128ToComplex64(dst []complex64, x []complex128)
+TEXT ·Complex128ToComplex64(SB), NOSPLIT, $0
+	MOVQ    dst_base+0(FP), DI  // DI = &dst
+	MOVQ    x_base+24(FP), SI   // SI = &x
+	MOVQ    x_len+32(FP), CX    // CX = min( len(x), len(dst) )
+	CMPQ    dst_len+8(FP), CX
+	CMOVQLE dst_len+8(FP), CX
+	CMPQ    CX, $0              // if CX == 0 { return }
+	JE      c128to64_end
+	XORQ    AX, AX              // i = 0
+	MOVQ    CX, BX
+	ANDQ    $3, BX              // BX = len % 4
+	SHRQ    $2, CX              // CX = floor( len / 4 )
+	JZ      c128to64_tail_start // if CX == 0 { goto c128to64_tail_start }
+
+c128to64_loop: // Loop unrolled 4x   do {
+	MOVUPS   (SI), X0       // X_i = { imag(x[i]), real(x[i]) }
+	MOVUPS   16(SI), X1
+	MOVUPS   32(SI), X2
+	MOVUPS   48(SI), X3
+	CVTPD2PS X0, X0         // X_i = { 0, 0, float32(imag(x[i])), float32(real(x[i])) }
+	CVTPD2PS X1, X1
+	CVTPD2PS X2, X2
+	CVTPD2PS X3, X3
+	MOVLHPS  X1, X0         // X0 = complex64(x[i:i+2])
+	MOVLHPS  X3, X2         // X2 = complex64(x[i+2:i+4])
+	MOVUPS   X0, (DI)(AX*8) // dst[i:i+4] = { X0, X2 }
+	MOVUPS   X2, 16(DI)(AX*8)
+	ADDQ     $64, SI        // SI = &(SI[4])
+	ADDQ     $4, AX         // i += 4
+	LOOP     c128to64_loop  // } while --CX > 0
+	CMPQ     BX, $0         // if BX == 0 { return }
+	JE       c128to64_end
+
+c128to64_tail_start:
+	MOVQ BX, CX
+
+c128to64_tail: // do {
+	MOVUPS   (SI), X0       // X0 = { imag(x[i]), real(x[i]) }
+	CVTPD2PS X0, X0         // X0 = { 0, 0, float32(imag(x[i])), float32(real(x[i])) }
+	MOVSD    X0, (DI)(AX*8) // dst[i] = complex64(x[i])
+	ADDQ     $16, SI        // SI = &(SI[1])
+	INCQ     AX             // i++
+	LOOP     c128to64_tail  // } while --CX > 0
+
+c128to64_end:
+	RET
@@ -0,0 +1,64 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+#include "textflag.h"
+
+// func Complex128ToComplex64Inc(dst []complex64, incDst, idst uintptr, x []complex128, n, incX, ix uintptr)
+TEXT ·Complex128ToComplex64Inc(SB), NOSPLIT, $0
+	MOVQ n+64(FP), CX         // CX = n
+	CMPQ CX, $0               // if n == 0 { return }
+	JE   c128to64i_end
+	MOVQ dst_base+0(FP), DI   // DI = &dst
+	MOVQ x_base+40(FP), SI    // SI = &x
+	MOVQ idst+32(FP), R8      // R8 = idst
+	MOVQ ix+80(FP), R9        // R9 = ix
+	SHLQ $3, R8               // R8 = idst * sizeof(complex64)
+	SHLQ $4, R9               // R9 = ix * sizeof(complex128)
+	ADDQ R8, DI               // DI = &(dst[idst])
+	ADDQ R9, SI               // SI = &(x[ix])
+	MOVQ incDst+24(FP), R8    // R8 = incDst
+	SHLQ $3, R8               // R8 *= sizeof(complex64)
+	MOVQ incX+72(FP), R9      // R9 = incX
+	SHLQ $4, R9               // R9 *= sizeof(complex128)
+	MOVQ CX, BX
+	ANDQ $3, BX               // BX = n % 4
+	SHRQ $2, CX               // CX = floor( n / 4 )
+	JZ   c128to64i_tail_start // if CX == 0 { goto c128to64i_tail_start }
+	LEAQ (R9)(R9*2), R10      // R10 = 3 * incX * sizeof(complex128)
+	LEAQ (R8)(R8*2), R11      // R11 = 3 * incDst * sizeof(complex64)
+
+c128to64i_loop: // Loop unrolled 4x   do {
+	MOVUPS   (SI), X0       // X_i = x[ix]
+	MOVUPS   (SI)(R9*1), X1
+	MOVUPS   (SI)(R9*2), X2
+	MOVUPS   (SI)(R10*1), X3
+	CVTPD2PS X0, X0         // X_i = complex64(X_i)
+	CVTPD2PS X1, X1
+	CVTPD2PS X2, X2
+	CVTPD2PS X3, X3
+	MOVSD    X0, (DI)       // dst[idst] = X_i
+	MOVSD    X1, (DI)(R8*1)
+	MOVSD    X2, (DI)(R8*2)
+	MOVSD    X3, (DI)(R11*1)
+	LEAQ     (SI)(R9*4), SI // SI = &(SI[incX*4])
+	LEAQ     (DI)(R8*4), DI // DI = &(DI[incDst*4])
+	LOOP     c128to64i_loop // } while --CX > 0
+	CMPQ     BX, $0         // if BX == 0 { return }
+	JE       c128to64i_end
+
+c128to64i_tail_start:
+	MOVQ BX, CX
+
+c128to64i_tail: // do {
+	MOVUPS   (SI), X0       // X0 = x[ix]
+	CVTPD2PS X0, X0         // X0 = complex64(X0)
+	MOVSD    X0, (DI)       // dst[idst] = X0
+	ADDQ     R9, SI         // SI = &(SI[incX])
+	ADDQ     R8, DI         // DI = &(DI[incDst])
+	LOOP     c128to64i_tail // } while --CX > 0
+
+c128to64i_end:
+	RET
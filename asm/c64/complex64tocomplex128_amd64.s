@@ -0,0 +1,50 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+#include "textflag.h"
+
+// func Complex64ToComplex128(dst []complex128, x []complex64)
+TEXT ·Complex64ToComplex128(SB), NOSPLIT, $0
+	MOVQ    dst_base+0(FP), DI  // DI = &dst
+	MOVQ    x_base+24(FP), SI   // SI = &x
+	MOVQ    x_len+32(FP), CX    // CX = min( len(x), len(dst) )
+	CMPQ    dst_len+8(FP), CX
+	CMOVQLE dst_len+8(FP), CX
+	CMPQ    CX, $0              // if CX == 0 { return }
+	JE      c64to128_end
+	XORQ    AX, AX              // i = 0
+	MOVQ    CX, BX
+	ANDQ    $3, BX              // BX = len % 4
+	SHRQ    $2, CX              // CX = floor( len / 4 )
+	JZ      c64to128_tail_start // if CX == 0 { goto c64to128_tail_start }
+
+c64to128_loop: // Loop unrolled 4x   do {
+	CVTPS2PD (SI)(AX*8), X0 // X_i = complex128(x[i])
+	CVTPS2PD 8(SI)(AX*8), X1
+	CVTPS2PD 16(SI)(AX*8), X2
+	CVTPS2PD 24(SI)(AX*8), X3
+	MOVUPS   X0, (DI)       // dst[i] = X_i
+	MOVUPS   X1, 16(DI)
+	MOVUPS   X2, 32(DI)
+	MOVUPS   X3, 48(DI)
+	ADDQ     $64, DI        // DI = &(DI[4])
+	ADDQ     $4, AX         // i += 4
+	LOOP     c64to128_loop  // } while --CX > 0
+	CMPQ     BX, $0         // if BX == 0 { return }
+	JE       c64to128_end
+
+c64to128_tail_start:
+	MOVQ BX, CX
+
+c64to128_tail: // do {
+	CVTPS2PD (SI)(AX*8), X0 // X0 = complex128(x[i])
+	MOVUPS   X0, (DI)       // dst[i] = X0
+	ADDQ     $16, DI        // DI = &(DI[1])
+	INCQ     AX             // i++
+	LOOP     c64to128_tail  // } while --CX > 0
+
+c64to128_end:
+	RET
@@ -0,0 +1,59 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+#include "textflag.h"
+
+// func Complex64ToComplex128Inc(dst []complex128, incDst, idst uintptr, x []complex64, n, incX, ix uintptr)
+TEXT ·Complex64ToComplex128Inc(SB), NOSPLIT, $0
+	MOVQ n+64(FP), CX         // CX = n
+	CMPQ CX, $0               // if n == 0 { return }
+	JE   c64to128i_end
+	MOVQ dst_base+0(FP), DI   // DI = &dst
+	MOVQ x_base+40(FP), SI    // SI = &x
+	MOVQ idst+32(FP), R8      // R8 = idst
+	MOVQ ix+80(FP), R9        // R9 = ix
+	SHLQ $4, R8               // R8 = idst * sizeof(complex128)
+	SHLQ $3, R9               // R9 = ix * sizeof(complex64)
+	ADDQ R8, DI               // DI = &(dst[idst])
+	ADDQ R9, SI               // SI = &(x[ix])
+	MOVQ incDst+24(FP), R8    // R8 = incDst
+	SHLQ $4, R8               // R8 *= sizeof(complex128)
+	MOVQ incX+72(FP), R9      // R9 = incX
+	SHLQ $3, R9               // R9 *= sizeof(complex64)
+	MOVQ CX, BX
+	ANDQ $3, BX               // BX = n % 4
+	SHRQ $2, CX               // CX = floor( n / 4 )
+	JZ   c64to128i_tail_start // if CX == 0 { goto c64to128i_tail_start }
+	LEAQ (R9)(R9*2), R10      // R10 = 3 * incX * sizeof(complex64)
+	LEAQ (R8)(R8*2), R11      // R11 = 3 * incDst * sizeof(complex128)
+
+c64to128i_loop: // Loop unrolled 4x   do {
+	CVTPS2PD (SI), X0       // X_i = complex128(x[ix])
+	CVTPS2PD (SI)(R9*1), X1
+	CVTPS2PD (SI)(R9*2), X2
+	CVTPS2PD (SI)(R10*1), X3
+	MOVUPS   X0, (DI)       // dst[idst] = X_i
+	MOVUPS   X1, (DI)(R8*1)
+	MOVUPS   X2, (DI)(R8*2)
+	MOVUPS   X3, (DI)(R11*1)
+	LEAQ     (SI)(R9*4), SI // SI = &(SI[incX*4])
+	LEAQ     (DI)(R8*4), DI // DI = &(DI[incDst*4])
+	LOOP     c64to128i_loop // } while --CX > 0
+	CMPQ     BX, $0         // if BX == 0 { return }
+	JE       c64to128i_end
+
+c64to128i_tail_start:
+	MOVQ BX, CX
+
+c64to128i_tail: // do {
+	CVTPS2PD (SI), X0       // X0 = complex128(x[ix])
+	MOVUPS   X0, (DI)       // dst[idst] = X0
+	ADDQ     R9, SI         // SI = &(SI[incX])
+	ADDQ     R8, DI         // DI = &(DI[incDst])
+	LOOP     c64to128i_tail // } while --CX > 0
+
+c64to128i_end:
+	RET
@@ -0,0 +1,115 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package c64
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// convSpecials are float64 parts that exercise the rounding, overflow
+// and underflow behaviour of conversion to complex64.
+var convSpecials = []float64{
+	0, math.Copysign(0, -1), 1, -1, math.Inf(1), math.Inf(-1), math.NaN(),
+	math.MaxFloat32, 2 * math.MaxFloat32, math.MaxFloat64,
+	math.SmallestNonzeroFloat32, math.SmallestNonzeroFloat32 / 3,
+	1 + 1.0/(1<<24), 1 + 3.0/(1<<24), 0.1,
+}
+
+func convPart(rnd *rand.Rand) float64 {
+	if rnd.Intn(4) == 0 {
+		return convSpecials[rnd.Intn(len(convSpecials))]
+	}
+	return rnd.NormFloat64() * math.Pow(2, float64(rnd.Intn(300)-150))
+}
+
+func TestComplex128ToComplex64(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	gd := complex64(complex(-7, 7))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 7, 8, 9, 31, 32, 33} {
+		for _, inc := range []struct{ x, dst uintptr }{{1, 1}, {2, 3}, {4, 1}} {
+			x := make([]complex128, n*int(inc.x))
+			for i := range x {
+				x[i] = complex(convPart(rnd), convPart(rnd))
+			}
+			prefix := fmt.Sprintf("n=%d incX=%d incDst=%d", n, inc.x, inc.dst)
+
+			dst := guardVector(make([]complex64, n), gd, 3)
+			Complex128ToComplex64(dst[3:3+n], x[:n])
+			for i, v := range x[:n] {
+				if !sameCmplx(dst[3+i], complex64(v)) {
+					t.Errorf("%s: unexpected Complex128ToComplex64 result at %d for %v: got %v want %v", prefix, i, v, dst[3+i], complex64(v))
+				}
+			}
+			if !isValidGuard(dst, gd, 3) {
+				t.Errorf("%s: Complex128ToComplex64 guard violated: %v", prefix, dst)
+			}
+
+			dst = guardIncVector(make([]complex64, n), gd, inc.dst, 3)
+			Complex128ToComplex64Inc(dst[3:len(dst)-3], inc.dst, 0, x, uintptr(n), inc.x, 0)
+			for i := range dst {
+				want := gd
+				if j := i - 3; j >= 0 && j%int(inc.dst) == 0 && j/int(inc.dst) < n {
+					want = complex64(x[j/int(inc.dst)*int(inc.x)])
+				}
+				if !sameCmplx(dst[i], want) {
+					t.Errorf("%s: unexpected Complex128ToComplex64Inc value at %d: got %v want %v", prefix, i, dst[i], want)
+				}
+			}
+		}
+	}
+}
+
+func TestComplex64ToComplex128(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	gd := complex(-7, 7)
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 7, 8, 9, 31, 32, 33} {
+		for _, inc := range []struct{ x, dst uintptr }{{1, 1}, {2, 3}, {4, 1}} {
+			x := make([]complex64, n*int(inc.x))
+			for i := range x {
+				x[i] = complex64(complex(convPart(rnd), convPart(rnd)))
+			}
+			prefix := fmt.Sprintf("n=%d incX=%d incDst=%d", n, inc.x, inc.dst)
+
+			dst := make([]complex128, n*int(inc.dst)+6)
+			for i := range dst {
+				dst[i] = gd
+			}
+			Complex64ToComplex128(dst[3:3+n], x[:n])
+			for i := range dst {
+				want := gd
+				if j := i - 3; j >= 0 && j < n {
+					want = complex128(x[j])
+				}
+				if !sameComplex128(dst[i], want) {
+					t.Errorf("%s: unexpected Complex64ToComplex128 value at %d: got %v want %v", prefix, i, dst[i], want)
+				}
+			}
+
+			for i := range dst {
+				dst[i] = gd
+			}
+			Complex64ToComplex128Inc(dst[3:len(dst)-3], inc.dst, 0, x, uintptr(n), inc.x, 0)
+			for i := range dst {
+				want := gd
+				if j := i - 3; j >= 0 && j%int(inc.dst) == 0 && j/int(inc.dst) < n {
+					want = complex128(x[j/int(inc.dst)*int(inc.x)])
+				}
+				if !sameComplex128(dst[i], want) {
+					t.Errorf("%s: unexpected Complex64ToComplex128Inc value at %d: got %v want %v", prefix, i, dst[i], want)
+				}
+			}
+		}
+	}
+}
+
+func sameComplex128(a, b complex128) bool {
+	same := func(x, y float64) bool {
+		return math.Float64bits(x) == math.Float64bits(y) || (math.IsNaN(x) && math.IsNaN(y))
+	}
+	return same(real(a), real(b)) && same(imag(a), imag(b))
+}
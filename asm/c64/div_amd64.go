@@ -0,0 +1,38 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+package c64
+
+// Div is
+//  for i, v := range s {
+//  	dst[i] /= v
+//  }
+func Div(dst, s []complex64) {
+	dst = dst[:len(s)]
+	for i := 0; i < len(s); i++ {
+		i += divTo(dst[i:], dst[i:], s[i:])
+		if i < len(s) {
+			dst[i] /= s[i]
+		}
+	}
+}
+
+// DivTo is
+//  for i, v := range x {
+//  	dst[i] = v / y[i]
+//  }
+//  return dst
+func DivTo(dst, x, y []complex64) []complex64 {
+	y = y[:len(x)]
+	dst = dst[:len(x)]
+	for i := 0; i < len(x); i++ {
+		i += divTo(dst[i:], x[i:], y[i:])
+		if i < len(x) {
+			dst[i] = x[i] / y[i]
+		}
+	}
+	return dst
+}
@@ -0,0 +1,105 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+#include "textflag.h"
+
+// The quotient is formed in float64 and rounded once to float32 to match
+// the complex64 division of the gc compiler.
+
+// func divTo(dst, x, y []complex64) int
+TEXT ·divTo(SB), NOSPLIT, $0
+	MOVQ    dst_base+0(FP), DI // DI = &dst
+	MOVQ    dst_len+8(FP), CX  // CX = min( len(dst), len(x), len(y) )
+	MOVQ    x_base+24(FP), SI  // SI = &x
+	MOVQ    y_base+48(FP), DX  // DX = &y
+	CMPQ    x_len+32(FP), CX
+	CMOVQLE x_len+32(FP), CX
+	CMPQ    y_len+56(FP), CX
+	CMOVQLE y_len+56(FP), CX
+	XORQ    AX, AX             // i = 0
+	CMPQ    CX, $0             // if CX == 0 { return 0 }
+	JE      div_end
+	PCMPEQL X15, X15           // X15 = { 0x7FFFFFFFFFFFFFFF, 0x7FFFFFFFFFFFFFFF }
+	PSRLQ   $1, X15
+	XORPS   X14, X14           // X14 = { 0, 0 }
+
+div_loop: // do {
+	MOVSD    (SI), X0 // X0 = { b, a } = { imag(x[i]), real(x[i]) }
+	MOVSD    (DX), X1 // X1 = { d, c } = { imag(y[i]), real(y[i]) }
+	CVTPS2PD X0, X0   // Widen to float64
+	CVTPS2PD X1, X1
+
+	// Leave the element to the caller if any part is Inf or NaN.
+	MOVAPS   X0, X2
+	SUBPD    X0, X2   // X2 = x[i] - x[i]
+	MOVAPS   X1, X3
+	SUBPD    X1, X3   // X3 = y[i] - y[i]
+	ADDPD    X3, X2
+	CMPPD    X2, X2, $3 // X2 = isNaN(X2)
+	MOVMSKPD X2, BX
+	CMPQ     BX, $0
+	JNE      div_end
+
+	// Leave the element to the caller if y[i] == 0.
+	MOVAPS   X1, X3
+	CMPPD    X14, X3, $0 // X3 = X3 == 0
+	MOVMSKPD X3, BX
+	CMPQ     BX, $3
+	JE       div_end
+
+	MOVHLPS X0, X5      // X5 = b
+	MOVHLPS X1, X7      // X7 = d
+	MOVAPS  X1, X2
+	ANDPD   X15, X2     // X2 = { |d|, |c| }
+	MOVHLPS X2, X3      // X3 = |d|
+	UCOMISD X3, X2      // if |c| < |d| { goto div_swap }
+	JCS     div_swap
+
+	MOVAPS X7, X8    // X8 = ratio = d / c
+	DIVSD  X1, X8
+	MOVAPS X8, X9    // X9 = denom = c + ratio*d
+	MULSD  X7, X9
+	ADDSD  X1, X9
+	MOVAPS X5, X10   // X10 = e = (a + b*ratio) / denom
+	MULSD  X8, X10
+	ADDSD  X0, X10
+	DIVSD  X9, X10
+	MOVAPS X0, X11   // X12 = f = (b - a*ratio) / denom
+	MULSD  X8, X11
+	MOVAPS X5, X12
+	SUBSD  X11, X12
+	DIVSD  X9, X12
+	JMP    div_store
+
+div_swap:
+	MOVAPS X1, X8  // X8 = ratio = c / d
+	DIVSD  X7, X8
+	MOVAPS X8, X9  // X9 = denom = d + ratio*c
+	MULSD  X1, X9
+	ADDSD  X7, X9
+	MOVAPS X0, X10 // X10 = e = (a*ratio + b) / denom
+	MULSD  X8, X10
+	ADDSD  X5, X10
+	DIVSD  X9, X10
+	MOVAPS X5, X12 // X12 = f = (b*ratio - a) / denom
+	MULSD  X8, X12
+	SUBSD  X0, X12
+	DIVSD  X9, X12
+
+div_store:
+	UNPCKLPD X12, X10 // X10 = { f, e }
+	CVTPD2PS X10, X10 // Narrow to float32
+	MOVSD    X10, (DI) // dst[i] = X10
+	ADDQ     $8, SI    // SI = &(x[i+1])
+	ADDQ     $8, DX    // DX = &(y[i+1])
+	ADDQ     $8, DI    // DI = &(dst[i+1])
+	INCQ     AX        // ++i
+	CMPQ     AX, CX
+	JNE      div_loop  // } while i < CX
+
+div_end:
+	MOVQ AX, ret+72(FP) // return i
+	RET
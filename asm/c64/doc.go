@@ -6,4 +6,17 @@
 // Development has moved to https://github.com/gonum/gonum.
 //
 // Package c64 provides complex64 vector primitives.
+//
+// Complex128ToComplex64 and Complex128ToComplex64Inc convert the real and
+// imaginary parts separately, rounding each as the Go conversion
+// complex64(v) does: to nearest, ties to even, with overflow to ±Inf.
+// Complex64ToComplex128 and Complex64ToComplex128Inc are exact.
+//
+// The dot product and matrix-vector kernels may add their terms in any
+// order. Each part of a complex product contributes two terms, and a
+// result part formed from m terms has an error of at most γ(m) times the
+// sum of the absolute values of the terms, where γ(m) = m·u/(1-m·u) and
+// u = 2^-24.
 package c64
+
+//go:generate go run ../internal/asmgen -pkg c64
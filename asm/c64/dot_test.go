@@ -0,0 +1,55 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package c64
+
+import (
+	"fmt"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+)
+
+// randVec returns a random vector of length n.
+func randVec(n int, rnd *rand.Rand) []complex64 {
+	v := make([]complex64, n)
+	for i := range v {
+		v[i] = complex(float32(rnd.NormFloat64()), float32(rnd.NormFloat64()))
+	}
+	return v
+}
+
+// near reports whether a and b agree to within a relative tolerance tol.
+func near(a, b complex64, tol float64) bool {
+	return cmplx.Abs(complex128(a-b)) <= tol*(1+cmplx.Abs(complex128(b)))
+}
+
+func TestDotUnitary(t *testing.T) {
+	const tol = 1e-5
+	var gdVal complex64 = 1 + 1i
+	rnd := rand.New(rand.NewSource(1))
+	for n := 0; n < 20; n++ {
+		for _, align := range []int{0, 1} {
+			prefix := fmt.Sprintf("n=%v align=%v", n, align)
+			xg := guardVector(randVec(n, rnd), gdVal, 4+align)
+			yg := guardVector(randVec(n, rnd), gdVal, 4)
+			x, y := xg[4+align:len(xg)-4-align], yg[4:len(yg)-4]
+
+			var wantu, wantc complex64
+			for i := range x {
+				wantu += x[i] * y[i]
+				wantc += y[i] * conj(x[i])
+			}
+			if got := DotuUnitary(x, y); !near(got, wantu, tol) {
+				t.Errorf("%v: unexpected DotuUnitary result: got %v want %v", prefix, got, wantu)
+			}
+			if got := DotcUnitary(x, y); !near(got, wantc, tol) {
+				t.Errorf("%v: unexpected DotcUnitary result: got %v want %v", prefix, got, wantc)
+			}
+			if !isValidGuard(xg, gdVal, 4+align) || !isValidGuard(yg, gdVal, 4) {
+				t.Errorf("%v: guard violated", prefix)
+			}
+		}
+	}
+}
@@ -4,18 +4,6 @@
 
 package c64
 
-// DotcUnitary is
-//  for i, v := range x {
-//  	sum += y[i] * conj(v)
-//  }
-//  return sum
-func DotcUnitary(x, y []complex64) (sum complex64) {
-	for i, v := range x {
-		sum += y[i] * conj(v)
-	}
-	return sum
-}
-
 // DotcInc is
 //  for i := 0; i < int(n); i++ {
 //  	sum += y[iy] * conj(x[ix])
@@ -0,0 +1,103 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+#include "textflag.h"
+
+// MOVSLDUP X4, X5
+#define MOVSLDUP_X4_X5 BYTE $0xF3; BYTE $0x0F; BYTE $0x12; BYTE $0xEC
+// MOVSLDUP X6, X7
+#define MOVSLDUP_X6_X7 BYTE $0xF3; BYTE $0x0F; BYTE $0x12; BYTE $0xFE
+// MOVSHDUP X4, X4
+#define MOVSHDUP_X4_X4 BYTE $0xF3; BYTE $0x0F; BYTE $0x16; BYTE $0xE4
+// MOVSHDUP X6, X6
+#define MOVSHDUP_X6_X6 BYTE $0xF3; BYTE $0x0F; BYTE $0x16; BYTE $0xF6
+
+// ADDSUBPS X1, X0
+#define ADDSUBPS_X1_X0 BYTE $0xF2; BYTE $0x0F; BYTE $0xD0; BYTE $0xC1
+
+// func DotuUnitary(x, y []complex64) (sum complex64)
+TEXT ·DotuUnitary(SB), NOSPLIT, $0
+	MOVQ    x_base+0(FP), SI  // SI = &x
+	MOVQ    y_base+24(FP), DI // DI = &y
+	MOVQ    x_len+8(FP), CX   // CX = min( len(x), len(y) )
+	CMPQ    y_len+32(FP), CX
+	CMOVQLE y_len+32(FP), CX
+	PXOR    X0, X0            // X0 = P = { 0, 0, 0, 0 }
+	PXOR    X1, X1            // X1 = Q = { 0, 0, 0, 0 }
+	CMPQ    CX, $0            // if CX == 0 { return 0 }
+	JE      dot_end
+	PXOR    X2, X2            // Second accumulator pair for pipelining
+	PXOR    X3, X3
+	XORQ    AX, AX            // i = 0
+	MOVQ    CX, BX
+	ANDQ    $3, CX            // CX = n % 4
+	SHRQ    $2, BX            // BX = floor( n / 4 )
+	JZ      dot_tail          // if BX == 0 { goto dot_tail }
+
+dot_loop: // do {
+	// X_i = { imag(x[i+1]), real(x[i+1]), imag(x[i]), real(x[i]) }
+	MOVUPS (SI)(AX*8), X4
+	MOVUPS 16(SI)(AX*8), X6
+
+	// X_j = { imag(y[i+1]), real(y[i+1]), imag(y[i]), real(y[i]) }
+	MOVUPS (DI)(AX*8), X8
+	MOVUPS 16(DI)(AX*8), X9
+
+	// X_(i+1) = { real(x[i+1]), real(x[i+1]), real(x[i]), real(x[i]) }
+	MOVSLDUP_X4_X5
+	MOVSLDUP_X6_X7
+
+	// X_i = { imag(x[i+1]), imag(x[i+1]), imag(x[i]), imag(x[i]) }
+	MOVSHDUP_X4_X4
+	MOVSHDUP_X6_X6
+
+	// X_(i+1) = { ..., real(x[i]) * imag(y[i]), real(x[i]) * real(y[i]) }
+	// X_i     = { ..., imag(x[i]) * imag(y[i]), imag(x[i]) * real(y[i]) }
+	MULPS X8, X5
+	MULPS X9, X7
+	MULPS X8, X4
+	MULPS X9, X6
+
+	// P += X_(i+1), Q += X_i
+	ADDPS X5, X0
+	ADDPS X7, X2
+	ADDPS X4, X1
+	ADDPS X6, X3
+
+	ADDQ  $4, AX   // i += 4
+	DECQ  BX
+	JNZ   dot_loop // } while --BX > 0
+	ADDPS X2, X0   // Combine accumulators
+	ADDPS X3, X1
+	CMPQ  CX, $0   // if CX == 0 { goto dot_end }
+	JE    dot_end
+
+dot_tail: // do {
+	MOVSD (SI)(AX*8), X4 // X4 = { 0, 0, imag(x[i]), real(x[i]) }
+	MOVSD (DI)(AX*8), X8 // X8 = { 0, 0, imag(y[i]), real(y[i]) }
+	MOVSLDUP_X4_X5       // X5 = { 0, 0, real(x[i]), real(x[i]) }
+	MOVSHDUP_X4_X4       // X4 = { 0, 0, imag(x[i]), imag(x[i]) }
+	MULPS X8, X5         // X5 = { 0, 0, real(x[i]) * imag(y[i]), real(x[i]) * real(y[i]) }
+	MULPS X8, X4         // X4 = { 0, 0, imag(x[i]) * imag(y[i]), imag(x[i]) * real(y[i]) }
+	ADDPS X5, X0         // P += X5
+	ADDPS X4, X1         // Q += X4
+	INCQ  AX             // ++i
+	LOOP  dot_tail       // } while --CX > 0
+
+dot_end:
+	MOVHLPS X0, X2 // Fold the upper pair into the lower pair
+	ADDPS   X2, X0
+	MOVHLPS X1, X3
+	ADDPS   X3, X1
+
+	// X0 = {
+	//	imag(sum): Σ real(x[i])*imag(y[i]) + Σ imag(x[i])*real(y[i]),
+	//	real(sum): Σ real(x[i])*real(y[i]) - Σ imag(x[i])*imag(y[i])
+	//  }
+	SHUFPS $0xB1, X1, X1
+	ADDSUBPS_X1_X0
+	MOVSD  X0, sum+48(FP)
+	RET
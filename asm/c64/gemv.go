@@ -0,0 +1,157 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package c64
+
+// The matrix-vector kernels below take A in row-major order with row
+// stride lda. Increments are two's complement encoded; a negative increment
+// addresses its vector from the last element, as in the reference BLAS.
+
+// GemvN computes
+//  y = alpha * A * x + beta * y
+// where A is an m×n dense matrix, x and y are vectors, and alpha and beta
+// are scalars.
+func GemvN(m, n uintptr, alpha complex64, a []complex64, lda uintptr, x []complex64, incX uintptr, beta complex64, y []complex64, incY uintptr) {
+	kx, ky := start(n, incX), start(m, incY)
+	scalVec(m, beta, y, incY)
+	if alpha == 0 {
+		return
+	}
+	iy := ky
+	for i := uintptr(0); i < m; i++ {
+		row := a[i*lda : i*lda+n]
+		var t complex64
+		if incX == 1 {
+			t = DotuUnitary(row, x)
+		} else {
+			t = DotuInc(row, x, n, 1, incX, 0, kx)
+		}
+		y[iy] += alpha * t
+		iy += incY
+	}
+}
+
+// GemvT computes
+//  y = alpha * A^T * x + beta * y
+// where A is an m×n dense matrix, x and y are vectors, and alpha and beta
+// are scalars.
+func GemvT(m, n uintptr, alpha complex64, a []complex64, lda uintptr, x []complex64, incX uintptr, beta complex64, y []complex64, incY uintptr) {
+	kx, ky := start(m, incX), start(n, incY)
+	scalVec(n, beta, y, incY)
+	if alpha == 0 {
+		return
+	}
+	ix := kx
+	for i := uintptr(0); i < m; i++ {
+		row := a[i*lda : i*lda+n]
+		t := alpha * x[ix]
+		if incY == 1 {
+			AxpyUnitary(t, row, y)
+		} else {
+			AxpyInc(t, row, y, n, 1, incY, 0, ky)
+		}
+		ix += incX
+	}
+}
+
+// GemvC computes
+//  y = alpha * A^H * x + beta * y
+// where A is an m×n dense matrix, A^H is its conjugate transpose, x and y
+// are vectors, and alpha and beta are scalars.
+func GemvC(m, n uintptr, alpha complex64, a []complex64, lda uintptr, x []complex64, incX uintptr, beta complex64, y []complex64, incY uintptr) {
+	kx, ky := start(m, incX), start(n, incY)
+	scalVec(n, beta, y, incY)
+	if alpha == 0 {
+		return
+	}
+	ix := kx
+	for i := uintptr(0); i < m; i++ {
+		row := a[i*lda : i*lda+n]
+		t := alpha * x[ix]
+		if incY == 1 {
+			axpyConjUnitary(t, row, y)
+		} else {
+			axpyConjInc(t, row, y, n, 1, incY, 0, ky)
+		}
+		ix += incX
+	}
+}
+
+// Hemv computes
+//  y = alpha * A * x + beta * y
+// where A is an n×n Hermitian matrix, x and y are vectors, and alpha and
+// beta are scalars. Only the upper triangle of A is referenced and the
+// imaginary parts of the diagonal elements are assumed to be zero.
+func Hemv(n uintptr, alpha complex64, a []complex64, lda uintptr, x []complex64, incX uintptr, beta complex64, y []complex64, incY uintptr) {
+	kx, ky := start(n, incX), start(n, incY)
+	scalVec(n, beta, y, incY)
+	if alpha == 0 {
+		return
+	}
+	if incX == 1 && incY == 1 {
+		for i := uintptr(0); i < n; i++ {
+			row := a[i*lda+i+1 : i*lda+n]
+			t := alpha * x[i]
+			y[i] += t*complex(real(a[i*lda+i]), 0) + alpha*DotuUnitary(row, x[i+1:])
+			axpyConjUnitary(t, row, y[i+1:])
+		}
+		return
+	}
+	ix, iy := kx, ky
+	for i := uintptr(0); i < n; i++ {
+		row := a[i*lda+i+1 : i*lda+n]
+		t := alpha * x[ix]
+		y[iy] += t*complex(real(a[i*lda+i]), 0) + alpha*DotuInc(row, x, n-i-1, 1, incX, 0, ix+incX)
+		axpyConjInc(t, row, y, n-i-1, 1, incY, 0, iy+incY)
+		ix += incX
+		iy += incY
+	}
+}
+
+// axpyConjInc is
+//  for i := 0; i < int(n); i++ {
+//  	y[iy] += alpha * conj(x[ix])
+//  	ix += incX
+//  	iy += incY
+//  }
+func axpyConjInc(alpha complex64, x, y []complex64, n, incX, incY, ix, iy uintptr) {
+	for i := 0; i < int(n); i++ {
+		y[iy] += alpha * conj(x[ix])
+		ix += incX
+		iy += incY
+	}
+}
+
+// start returns the index of the first element of a vector of length n
+// with increment inc.
+func start(n, inc uintptr) uintptr {
+	if int(inc) >= 0 || n == 0 {
+		return 0
+	}
+	return uintptr(-int(n-1) * int(inc))
+}
+
+// scalVec scales the n elements of y with increment incY by beta. When
+// beta is zero the elements are set to zero without being read.
+func scalVec(n uintptr, beta complex64, y []complex64, incY uintptr) {
+	if beta == 1 {
+		return
+	}
+	if int(incY) < 0 {
+		incY = -incY
+	}
+	if beta == 0 {
+		var iy uintptr
+		for i := uintptr(0); i < n; i++ {
+			y[iy] = 0
+			iy += incY
+		}
+		return
+	}
+	if incY == 1 {
+		ScalUnitary(beta, y[:n])
+		return
+	}
+	ScalInc(beta, y, n, incY)
+}
@@ -0,0 +1,136 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package c64
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+var (
+	inf = float32(math.Inf(1))
+	nan = float32(math.NaN())
+)
+
+// specials holds values that exercise the Inf, NaN, zero and
+// over/underflow handling of the elementwise kernels.
+var specials = []complex64{
+	0, complex(float32(math.Copysign(0, -1)), 0), 1, -1i, 1 + 1i,
+	complex(inf, 0), complex(0, -inf), complex(inf, inf), complex(-inf, 1),
+	complex(nan, 0), complex(1, nan), complex(nan, nan), complex(inf, nan),
+	complex(math.MaxFloat32, math.MaxFloat32), complex(math.SmallestNonzeroFloat32, 1),
+	complex(1e30, -1e-30), complex(-1e-30, 1e30),
+}
+
+// sameFloat reports whether a and b are bitwise equal ignoring NaN payloads.
+func sameFloat(a, b float32) bool {
+	if a != a || b != b {
+		return a != a && b != b
+	}
+	return a == b && math.Signbit(float64(a)) == math.Signbit(float64(b))
+}
+
+// sameCmplx reports whether the parts of a and b are bitwise equal
+// ignoring NaN payloads.
+func sameCmplx(a, b complex64) bool {
+	return sameFloat(real(a), real(b)) && sameFloat(imag(a), imag(b))
+}
+
+// elemVectors returns a pair of vectors of length n holding random values
+// with widely spread magnitudes, mixed with special values.
+func elemVectors(n int, rnd *rand.Rand) (x, y []complex64) {
+	x, y = make([]complex64, n), make([]complex64, n)
+	for i := range x {
+		x[i] = complex(float32(rnd.NormFloat64()*math.Pow(10, float64(rnd.Intn(20)-10))), float32(rnd.NormFloat64()))
+		y[i] = complex(float32(rnd.NormFloat64()), float32(rnd.NormFloat64()*math.Pow(10, float64(rnd.Intn(20)-10))))
+		if rnd.Intn(4) == 0 {
+			x[i] = specials[rnd.Intn(len(specials))]
+		}
+		if rnd.Intn(4) == 0 {
+			y[i] = specials[rnd.Intn(len(specials))]
+		}
+	}
+	return x, y
+}
+
+func TestElemSpecials(t *testing.T) {
+	var x, y []complex64
+	for _, a := range specials {
+		for _, b := range specials {
+			x = append(x, a)
+			y = append(y, b)
+		}
+	}
+	testElem(t, "specials", x, y)
+}
+
+func TestElemRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for n := 0; n < 40; n++ {
+		x, y := elemVectors(n, rnd)
+		testElem(t, "random", x, y)
+	}
+}
+
+func testElem(t *testing.T, name string, x, y []complex64) {
+	const gdLn = 4
+	var gdVal complex64 = 1 + 1i
+	for _, test := range []struct {
+		fn   string
+		op   func(a, b complex64) complex64
+		to   func(dst, x, y []complex64) []complex64
+		inPl func(dst, s []complex64)
+	}{
+		{
+			fn: "Mul",
+			op: func(a, b complex64) complex64 { return a * b },
+			to: MulTo, inPl: Mul,
+		},
+		{
+			fn: "MulConj",
+			op: func(a, b complex64) complex64 { return a * conj(b) },
+			to: MulConjTo, inPl: MulConj,
+		},
+		{
+			fn: "Div",
+			op: func(a, b complex64) complex64 { return a / b },
+			to: DivTo, inPl: Div,
+		},
+	} {
+		for _, align := range []int{0, 1} {
+			xg, yg := guardVector(x, gdVal, gdLn+align), guardVector(y, gdVal, gdLn)
+			dg := guardVector(make([]complex64, len(x)), gdVal, gdLn+1-align)
+			xs, ys := xg[gdLn+align:len(xg)-gdLn-align], yg[gdLn:len(yg)-gdLn]
+			dst := dg[gdLn+1-align : len(dg)-gdLn-1+align]
+
+			test.to(dst, xs, ys)
+			for i := range x {
+				want := test.op(x[i], y[i])
+				if !sameCmplx(dst[i], want) {
+					t.Errorf("%s %sTo(%v, %v) at %d: got %v want %v", name, test.fn, x[i], y[i], i, dst[i], want)
+				}
+			}
+			if !isValidGuard(dg, gdVal, gdLn+1-align) {
+				t.Errorf("%s %sTo: guard violated in dst vector", name, test.fn)
+			}
+
+			copy(dst, xs)
+			test.inPl(dst, ys)
+			for i := range x {
+				want := test.op(x[i], y[i])
+				if !sameCmplx(dst[i], want) {
+					t.Errorf("%s %s(%v, %v) at %d: got %v want %v", name, test.fn, x[i], y[i], i, dst[i], want)
+				}
+			}
+			if !isValidGuard(dg, gdVal, gdLn+1-align) {
+				t.Errorf("%s %s: guard violated in dst vector", name, test.fn)
+			}
+			if !isValidGuard(xg, gdVal, gdLn+align) || !isValidGuard(yg, gdVal, gdLn) {
+				t.Errorf("%s %s: guard violated in source vector", name, test.fn)
+			}
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+#include "textflag.h"
+
+// MOVDDUP X0, X2
+#define MOVDDUP_X0_X2 BYTE $0xF2; BYTE $0x0F; BYTE $0x12; BYTE $0xD0
+
+// ADDSUBPD X0, X2
+#define ADDSUBPD_X0_X2 BYTE $0x66; BYTE $0x0F; BYTE $0xD0; BYTE $0xD0
+
+// The product is formed in float64 and rounded once to float32 to match
+// the complex64 multiplication of the gc compiler.
+
+// func MulConj(dst, s []complex64)
+TEXT ·MulConj(SB), NOSPLIT, $0
+	MOVQ    dst_base+0(FP), DI // DI = &dst
+	MOVQ    DI, SI             // SI = &dst
+	MOVQ    dst_len+8(FP), CX  // CX = min( len(dst), len(s) )
+	MOVQ    s_base+24(FP), DX  // DX = &s
+	CMPQ    s_len+32(FP), CX
+	CMOVQLE s_len+32(FP), CX
+	CMPQ    CX, $0             // if CX == 0 { return }
+	JE      mul_end
+	PCMPEQL X8, X8             // X8 = { -0, 0 }
+	PSLLQ   $63, X8
+	PSLLO   $8, X8
+	XORQ    AX, AX             // i = 0
+
+mul_loop: // do {
+	MOVSD    (SI)(AX*8), X0 // X0 = { imag(dst[i]), real(dst[i]) }
+	MOVSD    (DX)(AX*8), X1 // X1 = { imag(s[i]), real(s[i]) }
+	CVTPS2PD X0, X0         // Widen to float64
+	CVTPS2PD X1, X1
+	XORPD    X8, X1         // X1 = { -imag(s[i]), real(s[i]) }
+	MOVDDUP_X0_X2           // X2 = { real(dst[i]), real(dst[i]) }
+	SHUFPD   $0x3, X0, X0   // X0 = { imag(dst[i]), imag(dst[i]) }
+	MOVAPS   X1, X3
+	SHUFPD   $0x1, X3, X3   // X3 = { real(s[i]), -imag(s[i]) }
+	MULPD    X1, X2         // X2 = { real(dst[i]) * -imag(s[i]), real(dst[i]) * real(s[i]) }
+	MULPD    X3, X0         // X0 = { imag(dst[i]) * real(s[i]), imag(dst[i]) * -imag(s[i]) }
+
+	// X2 = {
+	//	imag(result[i]):  real(dst[i])*-imag(s[i]) + imag(dst[i])*real(s[i]),
+	//	real(result[i]):  real(dst[i])*real(s[i]) - imag(dst[i])*-imag(s[i])
+	//  }
+	ADDSUBPD_X0_X2
+	CVTPD2PS X2, X2         // Narrow to float32
+	MOVSD    X2, (DI)(AX*8) // dst[i] = X2
+	INCQ     AX             // ++i
+	LOOP     mul_loop       // } while --CX > 0
+
+mul_end:
+	RET
@@ -0,0 +1,59 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+#include "textflag.h"
+
+// MOVDDUP X0, X2
+#define MOVDDUP_X0_X2 BYTE $0xF2; BYTE $0x0F; BYTE $0x12; BYTE $0xD0
+
+// ADDSUBPD X0, X2
+#define ADDSUBPD_X0_X2 BYTE $0x66; BYTE $0x0F; BYTE $0xD0; BYTE $0xD0
+
+// The product is formed in float64 and rounded once to float32 to match
+// the complex64 multiplication of the gc compiler.
+
+// func MulTo(dst, x, y []complex64) []complex64
+TEXT ·MulTo(SB), NOSPLIT, $0
+	MOVQ    dst_base+0(FP), DI // DI = &dst
+	MOVQ    dst_len+8(FP), CX  // CX = min( len(dst), len(x), len(y) )
+	MOVQ    x_base+24(FP), SI  // SI = &x
+	MOVQ    y_base+48(FP), DX  // DX = &y
+	CMPQ    x_len+32(FP), CX
+	CMOVQLE x_len+32(FP), CX
+	CMPQ    y_len+56(FP), CX
+	CMOVQLE y_len+56(FP), CX
+	MOVQ    CX, ret_len+80(FP) // len(ret) = CX
+	CMPQ    CX, $0             // if CX == 0 { return }
+	JE      mul_end
+	XORQ    AX, AX             // i = 0
+
+mul_loop: // do {
+	MOVSD    (SI)(AX*8), X0 // X0 = { imag(x[i]), real(x[i]) }
+	MOVSD    (DX)(AX*8), X1 // X1 = { imag(y[i]), real(y[i]) }
+	CVTPS2PD X0, X0         // Widen to float64
+	CVTPS2PD X1, X1
+	MOVDDUP_X0_X2           // X2 = { real(x[i]), real(x[i]) }
+	SHUFPD   $0x3, X0, X0   // X0 = { imag(x[i]), imag(x[i]) }
+	MOVAPS   X1, X3
+	SHUFPD   $0x1, X3, X3   // X3 = { real(y[i]), imag(y[i]) }
+	MULPD    X1, X2         // X2 = { real(x[i]) * imag(y[i]), real(x[i]) * real(y[i]) }
+	MULPD    X3, X0         // X0 = { imag(x[i]) * real(y[i]), imag(x[i]) * imag(y[i]) }
+
+	// X2 = {
+	//	imag(result[i]):  real(x[i])*imag(y[i]) + imag(x[i])*real(y[i]),
+	//	real(result[i]):  real(x[i])*real(y[i]) - imag(x[i])*imag(y[i])
+	//  }
+	ADDSUBPD_X0_X2
+	CVTPD2PS X2, X2         // Narrow to float32
+	MOVSD    X2, (DI)(AX*8) // dst[i] = X2
+	INCQ     AX             // ++i
+	LOOP     mul_loop       // } while --CX > 0
+
+mul_end:
+	MOVQ DI, ret_base+72(FP) // &ret = &dst
+	MOVQ dst_cap+16(FP), DI  // cap(ret) = cap(dst)
+	MOVQ DI, ret_cap+88(FP)
+	RET
@@ -0,0 +1,83 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18 && linux
+// +build go1.18,linux
+
+package c64
+
+import "github.com/gonum/internal/asm/internal/asmtest"
+
+// otherPageTests are the page tests for the kernels that are not
+// generated by asmgen.
+var otherPageTests = []pageTest{
+	{name: "Mul", incs: asmtest.PageUnitaryIncs, kernel: func(p *asmtest.Pages, n, _ int) {
+		dst := asmtest.PageVector[complex64](p, n, 1)
+		s := asmtest.PageVector[complex64](p, n, 1)
+		Mul(dst, s)
+	}},
+	{name: "MulConj", incs: asmtest.PageUnitaryIncs, kernel: func(p *asmtest.Pages, n, _ int) {
+		dst := asmtest.PageVector[complex64](p, n, 1)
+		s := asmtest.PageVector[complex64](p, n, 1)
+		MulConj(dst, s)
+	}},
+	{name: "Div", incs: asmtest.PageUnitaryIncs, kernel: func(p *asmtest.Pages, n, _ int) {
+		dst := asmtest.PageVector[complex64](p, n, 1)
+		s := asmtest.PageVector[complex64](p, n, 1)
+		Div(dst, s)
+	}},
+	{name: "MulTo", incs: asmtest.PageUnitaryIncs, kernel: func(p *asmtest.Pages, n, _ int) {
+		x := asmtest.PageVector[complex64](p, n, 1)
+		y := asmtest.PageVector[complex64](p, n, 1)
+		dst := asmtest.PageVector[complex64](p, n, 1)
+		MulTo(dst, x, y)
+	}},
+	{name: "MulConjTo", incs: asmtest.PageUnitaryIncs, kernel: func(p *asmtest.Pages, n, _ int) {
+		x := asmtest.PageVector[complex64](p, n, 1)
+		y := asmtest.PageVector[complex64](p, n, 1)
+		dst := asmtest.PageVector[complex64](p, n, 1)
+		MulConjTo(dst, x, y)
+	}},
+	{name: "DivTo", incs: asmtest.PageUnitaryIncs, kernel: func(p *asmtest.Pages, n, _ int) {
+		x := asmtest.PageVector[complex64](p, n, 1)
+		y := asmtest.PageVector[complex64](p, n, 1)
+		dst := asmtest.PageVector[complex64](p, n, 1)
+		DivTo(dst, x, y)
+	}},
+	{name: "axpyConjUnitary", incs: asmtest.PageUnitaryIncs, kernel: func(p *asmtest.Pages, n, _ int) {
+		x := asmtest.PageVector[complex64](p, n, 1)
+		y := asmtest.PageVector[complex64](p, n, 1)
+		axpyConjUnitary(1, x, y)
+	}},
+	{name: "RotUnitary", incs: asmtest.PageUnitaryIncs, kernel: func(p *asmtest.Pages, n, _ int) {
+		x := asmtest.PageVector[complex64](p, n, 1)
+		y := asmtest.PageVector[complex64](p, n, 1)
+		RotUnitary(0.5, 1i, x, y)
+	}},
+	{name: "RotInc", incs: asmtest.PageIncs, kernel: func(p *asmtest.Pages, n, inc int) {
+		x := asmtest.PageVector[complex64](p, n, inc)
+		y := asmtest.PageVector[complex64](p, n, -inc)
+		RotInc(0.5, 1i, x, y, uintptr(n), uintptr(inc), uintptr(-inc), uintptr(asmtest.Start(n, inc)), uintptr(asmtest.Start(n, -inc)))
+	}},
+	{name: "Complex128ToComplex64", incs: asmtest.PageUnitaryIncs, kernel: func(p *asmtest.Pages, n, _ int) {
+		x := asmtest.PageVector[complex128](p, n, 1)
+		dst := asmtest.PageVector[complex64](p, n, 1)
+		Complex128ToComplex64(dst, x)
+	}},
+	{name: "Complex128ToComplex64Inc", incs: asmtest.PageIncs, kernel: func(p *asmtest.Pages, n, inc int) {
+		x := asmtest.PageVector[complex128](p, n, inc)
+		dst := asmtest.PageVector[complex64](p, n, -inc)
+		Complex128ToComplex64Inc(dst, uintptr(-inc), uintptr(asmtest.Start(n, -inc)), x, uintptr(n), uintptr(inc), uintptr(asmtest.Start(n, inc)))
+	}},
+	{name: "Complex64ToComplex128", incs: asmtest.PageUnitaryIncs, kernel: func(p *asmtest.Pages, n, _ int) {
+		x := asmtest.PageVector[complex64](p, n, 1)
+		dst := asmtest.PageVector[complex128](p, n, 1)
+		Complex64ToComplex128(dst, x)
+	}},
+	{name: "Complex64ToComplex128Inc", incs: asmtest.PageIncs, kernel: func(p *asmtest.Pages, n, inc int) {
+		x := asmtest.PageVector[complex64](p, n, inc)
+		dst := asmtest.PageVector[complex128](p, n, -inc)
+		Complex64ToComplex128Inc(dst, uintptr(-inc), uintptr(asmtest.Start(n, -inc)), x, uintptr(n), uintptr(inc), uintptr(asmtest.Start(n, inc)))
+	}},
+}
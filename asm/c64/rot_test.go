@@ -0,0 +1,144 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package c64
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+)
+
+func TestRot(t *testing.T) {
+	var gdVal complex64 = 1 + 1i
+	rnd := rand.New(rand.NewSource(1))
+	for _, cs := range []struct {
+		c float32
+		s complex64
+	}{
+		{c: 1, s: 0},
+		{c: 0, s: 1i},
+		{c: 0.6, s: 0.48 + 0.64i},
+		{c: float32(math.Sqrt(0.5)), s: complex(-0.5, 0.5)},
+	} {
+		for n := 0; n < 10; n++ {
+			x, y := elemVectors(n, rnd)
+			wantX, wantY := make([]complex64, n), make([]complex64, n)
+			for i := range x {
+				wantX[i] = complex(cs.c*real(x[i]), cs.c*imag(x[i])) + cs.s*y[i]
+				wantY[i] = complex(cs.c*real(y[i]), cs.c*imag(y[i])) - conj(cs.s)*x[i]
+			}
+
+			for _, align := range []int{0, 1} {
+				prefix := fmt.Sprintf("RotUnitary c=%v s=%v n=%v align=%v", cs.c, cs.s, n, align)
+				xg, yg := guardVector(x, gdVal, 4+align), guardVector(y, gdVal, 4)
+				xs, ys := xg[4+align:len(xg)-4-align], yg[4:len(yg)-4]
+				RotUnitary(cs.c, cs.s, xs, ys)
+				for i := range wantX {
+					if !sameCmplx(xs[i], wantX[i]) || !sameCmplx(ys[i], wantY[i]) {
+						t.Errorf("%v: unexpected result at %d: got (%v, %v) want (%v, %v)",
+							prefix, i, xs[i], ys[i], wantX[i], wantY[i])
+					}
+				}
+				if !isValidGuard(xg, gdVal, 4+align) || !isValidGuard(yg, gdVal, 4) {
+					t.Errorf("%v: guard violated", prefix)
+				}
+			}
+
+			for _, inc := range []struct{ x, y int }{{1, 1}, {2, 3}, {-1, 2}, {3, -2}} {
+				prefix := fmt.Sprintf("RotInc c=%v s=%v n=%v incX=%v incY=%v", cs.c, cs.s, n, inc.x, inc.y)
+				xg := guardIncVector(x, gdVal, uintptr(inc.x), 4)
+				yg := guardIncVector(y, gdVal, uintptr(inc.y), 4)
+				xs, ys := xg[4:len(xg)-4], yg[4:len(yg)-4]
+				var ix, iy int
+				if inc.x < 0 {
+					ix = (1 - n) * inc.x
+				}
+				if inc.y < 0 {
+					iy = (1 - n) * inc.y
+				}
+				wantXs := append([]complex64(nil), xs...)
+				wantYs := append([]complex64(nil), ys...)
+				for i, jx, jy := 0, ix, iy; i < n; i++ {
+					vx, vy := wantXs[jx], wantYs[jy]
+					wantXs[jx] = complex(cs.c*real(vx), cs.c*imag(vx)) + cs.s*vy
+					wantYs[jy] = complex(cs.c*real(vy), cs.c*imag(vy)) - conj(cs.s)*vx
+					jx += inc.x
+					jy += inc.y
+				}
+				RotInc(cs.c, cs.s, xs, ys, uintptr(n), uintptr(inc.x), uintptr(inc.y), uintptr(ix), uintptr(iy))
+				for i := 0; i < n; i++ {
+					jx, jy := ix+i*inc.x, iy+i*inc.y
+					if !sameCmplx(xs[jx], wantXs[jx]) || !sameCmplx(ys[jy], wantYs[jy]) {
+						t.Errorf("%v: unexpected result at %d: got (%v, %v) want (%v, %v)",
+							prefix, i, xs[jx], ys[jy], wantXs[jx], wantYs[jy])
+					}
+				}
+				checkValidIncGuard(t, xg, gdVal, uintptr(inc.x), 4)
+				checkValidIncGuard(t, yg, gdVal, uintptr(inc.y), 4)
+			}
+		}
+	}
+}
+
+func TestRotg(t *testing.T) {
+	const tol = 1e-6
+	rnd := rand.New(rand.NewSource(1))
+	rndCmplx := func(scale float64) complex64 {
+		return complex(float32(rnd.NormFloat64()*scale), float32(rnd.NormFloat64()*scale))
+	}
+	var cases [][2]complex64
+	for _, scale := range []float64{1, 1e-30, 1e30, 1e-20, 1e20} {
+		for i := 0; i < 10; i++ {
+			cases = append(cases,
+				[2]complex64{rndCmplx(scale), rndCmplx(1)},
+				[2]complex64{rndCmplx(1), rndCmplx(scale)},
+			)
+		}
+	}
+	cases = append(cases,
+		[2]complex64{0, 0}, [2]complex64{1 + 2i, 0}, [2]complex64{0, 3 - 4i},
+		[2]complex64{0, 5i}, [2]complex64{0, -2}, [2]complex64{0, 1e30 + 1e30i},
+		[2]complex64{1e-40, 1e30}, [2]complex64{1e30i, 1e-40},
+		[2]complex64{1e38, 1e38i}, [2]complex64{-1e38 + 1e38i, 1},
+	)
+	for _, fg := range cases {
+		f, g := fg[0], fg[1]
+		c, s, r := Rotg(f, g)
+		prefix := fmt.Sprintf("f=%v g=%v", f, g)
+		if c < 0 || c > 1 || c != c {
+			t.Errorf("%v: unexpected c=%v", prefix, c)
+		}
+		if cmplx.IsNaN(complex128(s)) || cmplx.IsInf(complex128(s)) || cmplx.IsNaN(complex128(r)) || cmplx.IsInf(complex128(r)) {
+			t.Errorf("%v: non-finite result s=%v r=%v", prefix, s, r)
+			continue
+		}
+		if d := math.Abs(float64(c*c + absSq(s) - 1)); d > tol {
+			t.Errorf("%v: rotation not unitary: c^2+|s|^2-1=%v", prefix, d)
+		}
+		// Check the rotated vector in float64 with f and g scaled to unit
+		// size so the residuals can be tested without overflow.
+		f128, g128, r128, s128 := complex128(f), complex128(g), complex128(r), complex128(s)
+		scale := math.Max(cmplx.Abs(f128), cmplx.Abs(g128))
+		if scale == 0 {
+			if c != 1 || s != 0 || r != 0 {
+				t.Errorf("%v: unexpected result c=%v s=%v r=%v", prefix, c, s, r)
+			}
+			continue
+		}
+		inv := complex(1/scale, 0)
+		fs, gs, rs := f128*inv, g128*inv, r128*inv
+		if d := cmplx.Abs(complex(float64(c), 0)*fs + s128*gs - rs); d > tol {
+			t.Errorf("%v: |c*f + s*g - r| = %v", prefix, d)
+		}
+		if d := cmplx.Abs(complex(float64(c), 0)*gs - cmplx.Conj(s128)*fs); d > tol {
+			t.Errorf("%v: |c*g - conj(s)*f| = %v", prefix, d)
+		}
+		if f == 0 && imag(r) != 0 {
+			t.Errorf("%v: r not real for zero f: %v", prefix, r)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package c64
+
+import "math"
+
+const (
+	safmin = 1.17549435e-38 // Smallest normalized float32.
+	safmax = 1 / safmin
+)
+
+// Rotg computes the plane rotation
+//  [  c         s ] [ f ]   [ r ]
+//  [ -conj(s)   c ] [ g ] = [ 0 ]
+// with real cosine c ≥ 0 and complex sine s, such that c^2 + |s|^2 = 1.
+// It follows the scaling strategy of LAPACK's clartg so that intermediate
+// results neither overflow nor underflow unnecessarily. If g is zero then
+// c = 1, s = 0 and r = f. If f is zero then c = 0 and r is real.
+func Rotg(f, g complex64) (c float32, s, r complex64) {
+	rtmin := sqrt(safmin)
+	switch {
+	case g == 0:
+		return 1, 0, f
+	case f == 0:
+		if real(g) == 0 || imag(g) == 0 {
+			d := abs(g)
+			return 0, divReal(conj(g), d), complex(d, 0)
+		}
+		g1 := max32(abs32(real(g)), abs32(imag(g)))
+		rtmax := sqrt(safmax / 2)
+		if rtmin < g1 && g1 < rtmax {
+			// Use unscaled algorithm.
+			d := sqrt(absSq(g))
+			return 0, divReal(conj(g), d), complex(d, 0)
+		}
+		// Use scaled algorithm.
+		u := min32(safmax, max32(safmin, g1))
+		gs := divReal(g, u)
+		d := sqrt(absSq(gs))
+		return 0, divReal(conj(gs), d), complex(d*u, 0)
+	}
+
+	f1 := max32(abs32(real(f)), abs32(imag(f)))
+	g1 := max32(abs32(real(g)), abs32(imag(g)))
+	rtmax := sqrt(safmax / 4)
+	if rtmin < f1 && f1 < rtmax && rtmin < g1 && g1 < rtmax {
+		// Use unscaled algorithm.
+		f2 := absSq(f)
+		h2 := f2 + absSq(g)
+		c, s, r = rotgScaled(f, g, f2, h2, rtmin, rtmax)
+		return c, s, r
+	}
+
+	// Use scaled algorithm.
+	u := min32(safmax, max32(safmin, max32(f1, g1)))
+	gs := divReal(g, u)
+	g2 := absSq(gs)
+	var (
+		w      float32
+		fs     complex64
+		f2, h2 float32
+	)
+	if f1/u < rtmin {
+		// f is not well-scaled when scaled by g1, so
+		// use a different scaling for f.
+		v := min32(safmax, max32(safmin, f1))
+		w = v / u
+		fs = divReal(f, v)
+		f2 = absSq(fs)
+		h2 = f2*w*w + g2
+	} else {
+		// Otherwise use the same scaling for f and g.
+		w = 1
+		fs = divReal(f, u)
+		f2 = absSq(fs)
+		h2 = f2 + g2
+	}
+	c, s, r = rotgScaled(fs, gs, f2, h2, rtmin, rtmax)
+	return c * w, s, complex(real(r)*u, imag(r)*u)
+}
+
+// rotgScaled computes the rotation for f and g where f2 = |f|^2 and
+// h2 = |f|^2 + |g|^2 satisfy safmin ≤ f2 ≤ h2 ≤ safmax.
+func rotgScaled(f, g complex64, f2, h2, rtmin, rtmax float32) (c float32, s, r complex64) {
+	if f2 >= h2*safmin {
+		// safmin ≤ f2/h2 ≤ 1, and h2/f2 is finite.
+		c = sqrt(f2 / h2)
+		r = divReal(f, c)
+		rtmax *= 2
+		if f2 > rtmin && h2 < rtmax {
+			// safmin ≤ sqrt(f2*h2) ≤ safmax.
+			s = conj(g) * divReal(f, sqrt(f2*h2))
+		} else {
+			s = conj(g) * divReal(r, h2)
+		}
+		return c, s, r
+	}
+	// f2/h2 ≤ safmin may be subnormal, and h2/f2 may overflow.
+	d := sqrt(f2 * h2)
+	c = f2 / d
+	if c >= safmin {
+		r = divReal(f, c)
+	} else {
+		// f2/sqrt(f2*h2) < safmin, so scale f by h2/d instead.
+		r = mulReal(f, h2/d)
+	}
+	s = conj(g) * divReal(f, d)
+	return c, s, r
+}
+
+// absSq returns |z|^2.
+func absSq(z complex64) float32 {
+	return real(z)*real(z) + imag(z)*imag(z)
+}
+
+// divReal returns z/a for real a.
+func divReal(z complex64, a float32) complex64 {
+	return complex(real(z)/a, imag(z)/a)
+}
+
+// mulReal returns z*a for real a.
+func mulReal(z complex64, a float32) complex64 {
+	return complex(real(z)*a, imag(z)*a)
+}
+
+// abs returns |z|.
+func abs(z complex64) float32 {
+	return float32(math.Hypot(float64(real(z)), float64(imag(z))))
+}
+
+// sqrt returns the correctly rounded square root of a.
+func sqrt(a float32) float32 {
+	return float32(math.Sqrt(float64(a)))
+}
+
+// abs32 returns |a|.
+func abs32(a float32) float32 {
+	return math.Float32frombits(math.Float32bits(a) &^ (1 << 31))
+}
+
+// max32 returns the larger of a and b.
+func max32(a, b float32) float32 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// min32 returns the smaller of a and b.
+func min32(a, b float32) float32 {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,84 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+#include "textflag.h"
+
+// MOVDDUP X8, X7
+#define MOVDDUP_X8_X7 BYTE $0xF2; BYTE $0x41; BYTE $0x0F; BYTE $0x12; BYTE $0xF8
+// MOVDDUP X10, X9
+#define MOVDDUP_X10_X9 BYTE $0xF2; BYTE $0x45; BYTE $0x0F; BYTE $0x12; BYTE $0xCA
+
+// ADDSUBPD X8, X7
+#define ADDSUBPD_X8_X7 BYTE $0x66; BYTE $0x41; BYTE $0x0F; BYTE $0xD0; BYTE $0xF8
+// ADDSUBPD X10, X9
+#define ADDSUBPD_X10_X9 BYTE $0x66; BYTE $0x45; BYTE $0x0F; BYTE $0xD0; BYTE $0xCA
+
+// The complex products are formed in float64 and rounded once to float32
+// to match the complex64 multiplication of the gc compiler.
+
+// func RotInc(c float32, s complex64, x, y []complex64, n, incX, incY, ix, iy uintptr)
+TEXT ·RotInc(SB), NOSPLIT, $0
+	MOVQ     x_base+16(FP), SI // SI = &x
+	MOVQ     y_base+40(FP), DI // DI = &y
+	MOVQ     n+64(FP), CX      // CX = n
+	CMPQ     CX, $0            // if n == 0 { return }
+	JE       rot_end
+	MOVQ     ix+88(FP), R8     // R8 = ix
+	MOVQ     iy+96(FP), R9     // R9 = iy
+	LEAQ     (SI)(R8*8), SI    // SI = &(x[ix])
+	LEAQ     (DI)(R9*8), DI    // DI = &(y[iy])
+	MOVQ     incX+72(FP), R8   // R8 = incX
+	SHLQ     $3, R8            // R8 *= sizeof(complex64)
+	MOVQ     incY+80(FP), R9   // R9 = incY
+	SHLQ     $3, R9            // R9 *= sizeof(complex64)
+	MOVSS    c+0(FP), X0       // X0 = { c, c, c, c }
+	SHUFPS   $0, X0, X0
+	MOVSD    s+4(FP), X1       // X1 = { imag(s), real(s) } as float64
+	CVTPS2PD X1, X1
+	MOVAPS   X1, X2
+	SHUFPD   $0x1, X2, X2      // X2 = { real(s), imag(s) }
+	PCMPEQL  X11, X11          // X11 = { -0, 0 }
+	PSLLQ    $63, X11
+	PSLLO    $8, X11
+	MOVAPS   X1, X3
+	XORPD    X11, X3           // X3 = { -imag(s), real(s) }
+	MOVAPS   X3, X4
+	SHUFPD   $0x1, X4, X4      // X4 = { real(s), -imag(s) }
+
+rot_loop: // do {
+	MOVSD (SI), X5 // X5 = { imag(x[i]), real(x[i]) }
+	MOVSD (DI), X6 // X6 = { imag(y[i]), real(y[i]) }
+
+	// X7 = s * y[i]
+	CVTPS2PD X6, X8       // X8 = { imag(y[i]), real(y[i]) } as float64
+	MOVDDUP_X8_X7         // X7 = { real(y[i]), real(y[i]) }
+	SHUFPD   $0x3, X8, X8 // X8 = { imag(y[i]), imag(y[i]) }
+	MULPD    X1, X7       // X7 = { imag(s) * real(y[i]), real(s) * real(y[i]) }
+	MULPD    X2, X8       // X8 = { real(s) * imag(y[i]), imag(s) * imag(y[i]) }
+	ADDSUBPD_X8_X7
+	CVTPD2PS X7, X7
+
+	// X9 = conj(s) * x[i]
+	CVTPS2PD X5, X10        // X10 = { imag(x[i]), real(x[i]) } as float64
+	MOVDDUP_X10_X9          // X9 = { real(x[i]), real(x[i]) }
+	SHUFPD   $0x3, X10, X10 // X10 = { imag(x[i]), imag(x[i]) }
+	MULPD    X3, X9         // X9 = { -imag(s) * real(x[i]), real(s) * real(x[i]) }
+	MULPD    X4, X10        // X10 = { real(s) * imag(x[i]), -imag(s) * imag(x[i]) }
+	ADDSUBPD_X10_X9
+	CVTPD2PS X9, X9
+
+	MULPS X0, X5         // x[i] = c*x[i] + s*y[i]
+	ADDPS X7, X5
+	MULPS X0, X6         // y[i] = c*y[i] - conj(s)*x[i]
+	SUBPS X9, X6
+	MOVSD X5, (SI)
+	MOVSD X6, (DI)
+	ADDQ  R8, SI         // SI = &(SI[incX])
+	ADDQ  R9, DI         // DI = &(DI[incY])
+	LOOP  rot_loop       // } while --CX > 0
+
+rot_end:
+	RET
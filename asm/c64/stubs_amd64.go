@@ -34,3 +34,101 @@ func AxpyInc(alpha complex64, x, y []complex64, n, incX, incY, ix, iy uintptr)
 //  	idst += incDst
 //  }
 func AxpyIncTo(dst []complex64, incDst, idst uintptr, alpha complex64, x, y []complex64, n, incX, incY, ix, iy uintptr)
+
+// Mul is
+//  for i, v := range s {
+//  	dst[i] *= v
+//  }
+func Mul(dst, s []complex64)
+
+// MulTo is
+//  for i, v := range x {
+//  	dst[i] = v * y[i]
+//  }
+//  return dst
+func MulTo(dst, x, y []complex64) []complex64
+
+// MulConj is
+//  for i, v := range s {
+//  	dst[i] *= cmplx.Conj(v)
+//  }
+func MulConj(dst, s []complex64)
+
+// MulConjTo is
+//  for i, v := range x {
+//  	dst[i] = v * cmplx.Conj(y[i])
+//  }
+//  return dst
+func MulConjTo(dst, x, y []complex64) []complex64
+
+// divTo computes dst[i] = x[i] / y[i] in float64 using Smith's algorithm,
+// as the Go runtime does, and returns the number of elements processed.
+// It stops at the first element where either operand is not finite
+// or y[i] is zero, leaving that element for the caller.
+func divTo(dst, x, y []complex64) int
+
+// DotuUnitary is
+//  for i, v := range x {
+//  	sum += y[i] * v
+//  }
+//  return sum
+func DotuUnitary(x, y []complex64) (sum complex64)
+
+// DotcUnitary is
+//  for i, v := range x {
+//  	sum += y[i] * conj(v)
+//  }
+//  return sum
+func DotcUnitary(x, y []complex64) (sum complex64)
+
+// axpyConjUnitary is
+//  for i, v := range x {
+//  	y[i] += alpha * conj(v)
+//  }
+func axpyConjUnitary(alpha complex64, x, y []complex64)
+
+// RotUnitary is
+//  for i, vx := range x {
+//  	vy := y[i]
+//  	x[i] = complex(c*real(vx), c*imag(vx)) + s*vy
+//  	y[i] = complex(c*real(vy), c*imag(vy)) - conj(s)*vx
+//  }
+func RotUnitary(c float32, s complex64, x, y []complex64)
+
+// RotInc is
+//  for i := 0; i < int(n); i++ {
+//  	vx, vy := x[ix], y[iy]
+//  	x[ix] = complex(c*real(vx), c*imag(vx)) + s*vy
+//  	y[iy] = complex(c*real(vy), c*imag(vy)) - conj(s)*vx
+//  	ix += incX
+//  	iy += incY
+//  }
+func RotInc(c float32, s complex64, x, y []complex64, n, incX, incY, ix, iy uintptr)
+
+// Complex128ToComplex64 is
+//  for i, v := range x {
+//  	dst[i] = complex64(v)
+//  }
+func Complex128ToComplex64(dst []complex64, x []complex128)
+
+// Complex128ToComplex64Inc is
+//  for i := 0; i < int(n); i++ {
+//  	dst[idst] = complex64(x[ix])
+//  	ix += incX
+//  	idst += incDst
+//  }
+func Complex128ToComplex64Inc(dst []complex64, incDst, idst uintptr, x []complex128, n, incX, ix uintptr)
+
+// Complex64ToComplex128 is
+//  for i, v := range x {
+//  	dst[i] = complex128(v)
+//  }
+func Complex64ToComplex128(dst []complex128, x []complex64)
+
+// Complex64ToComplex128Inc is
+//  for i := 0; i < int(n); i++ {
+//  	dst[idst] = complex128(x[ix])
+//  	ix += incX
+//  	idst += incDst
+//  }
+func Complex64ToComplex128Inc(dst []complex128, incDst, idst uintptr, x []complex64, n, incX, ix uintptr)
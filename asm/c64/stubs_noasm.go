@@ -55,3 +55,183 @@ func AxpyIncTo(dst []complex64, incDst, idst uintptr, alpha complex64, x, y []co
 		idst += incDst
 	}
 }
+
+// Mul is
+//  for i, v := range s {
+//  	dst[i] *= v
+//  }
+func Mul(dst, s []complex64) {
+	for i, v := range s {
+		dst[i] *= v
+	}
+}
+
+// MulTo is
+//  for i, v := range x {
+//  	dst[i] = v * y[i]
+//  }
+//  return dst
+func MulTo(dst, x, y []complex64) []complex64 {
+	for i, v := range x {
+		dst[i] = v * y[i]
+	}
+	return dst
+}
+
+// MulConj is
+//  for i, v := range s {
+//  	dst[i] *= cmplx.Conj(v)
+//  }
+func MulConj(dst, s []complex64) {
+	for i, v := range s {
+		dst[i] *= conj(v)
+	}
+}
+
+// MulConjTo is
+//  for i, v := range x {
+//  	dst[i] = v * cmplx.Conj(y[i])
+//  }
+//  return dst
+func MulConjTo(dst, x, y []complex64) []complex64 {
+	for i, v := range x {
+		dst[i] = v * conj(y[i])
+	}
+	return dst
+}
+
+// Div is
+//  for i, v := range s {
+//  	dst[i] /= v
+//  }
+func Div(dst, s []complex64) {
+	for i, v := range s {
+		dst[i] /= v
+	}
+}
+
+// DivTo is
+//  for i, v := range x {
+//  	dst[i] = v / y[i]
+//  }
+//  return dst
+func DivTo(dst, x, y []complex64) []complex64 {
+	for i, v := range x {
+		dst[i] = v / y[i]
+	}
+	return dst
+}
+
+// DotuUnitary is
+//  for i, v := range x {
+//  	sum += y[i] * v
+//  }
+//  return sum
+func DotuUnitary(x, y []complex64) (sum complex64) {
+	for i, v := range x {
+		sum += y[i] * v
+	}
+	return sum
+}
+
+// DotcUnitary is
+//  for i, v := range x {
+//  	sum += y[i] * conj(v)
+//  }
+//  return sum
+func DotcUnitary(x, y []complex64) (sum complex64) {
+	for i, v := range x {
+		sum += y[i] * conj(v)
+	}
+	return sum
+}
+
+// axpyConjUnitary is
+//  for i, v := range x {
+//  	y[i] += alpha * conj(v)
+//  }
+func axpyConjUnitary(alpha complex64, x, y []complex64) {
+	for i, v := range x {
+		y[i] += alpha * conj(v)
+	}
+}
+
+// RotUnitary is
+//  for i, vx := range x {
+//  	vy := y[i]
+//  	x[i] = complex(c*real(vx), c*imag(vx)) + s*vy
+//  	y[i] = complex(c*real(vy), c*imag(vy)) - conj(s)*vx
+//  }
+func RotUnitary(c float32, s complex64, x, y []complex64) {
+	for i, vx := range x {
+		vy := y[i]
+		x[i] = complex(c*real(vx), c*imag(vx)) + s*vy
+		y[i] = complex(c*real(vy), c*imag(vy)) - conj(s)*vx
+	}
+}
+
+// RotInc is
+//  for i := 0; i < int(n); i++ {
+//  	vx, vy := x[ix], y[iy]
+//  	x[ix] = complex(c*real(vx), c*imag(vx)) + s*vy
+//  	y[iy] = complex(c*real(vy), c*imag(vy)) - conj(s)*vx
+//  	ix += incX
+//  	iy += incY
+//  }
+func RotInc(c float32, s complex64, x, y []complex64, n, incX, incY, ix, iy uintptr) {
+	for i := 0; i < int(n); i++ {
+		vx, vy := x[ix], y[iy]
+		x[ix] = complex(c*real(vx), c*imag(vx)) + s*vy
+		y[iy] = complex(c*real(vy), c*imag(vy)) - conj(s)*vx
+		ix += incX
+		iy += incY
+	}
+}
+
+// Complex128ToComplex64 is
+//  for i, v := range x {
+//  	dst[i] = complex64(v)
+//  }
+func Complex128ToComplex64(dst []complex64, x []complex128) {
+	for i, v := range x {
+		dst[i] = complex64(v)
+	}
+}
+
+// Complex128ToComplex64Inc is
+//  for i := 0; i < int(n); i++ {
+//  	dst[idst] = complex64(x[ix])
+//  	ix += incX
+//  	idst += incDst
+//  }
+func Complex128ToComplex64Inc(dst []complex64, incDst, idst uintptr, x []complex128, n, incX, ix uintptr) {
+	for i := 0; i < int(n); i++ {
+		dst[idst] = complex64(x[ix])
+		ix += incX
+		idst += incDst
+	}
+}
+
+// Complex64ToComplex128 is
+//  for i, v := range x {
+//  	dst[i] = complex128(v)
+//  }
+func Complex64ToComplex128(dst []complex128, x []complex64) {
+	for i, v := range x {
+		dst[i] = complex128(v)
+	}
+}
+
+// Complex64ToComplex128Inc is
+//  for i := 0; i < int(n); i++ {
+//  	dst[idst] = complex128(x[ix])
+//  	ix += incX
+//  	idst += incDst
+//  }
+func Complex64ToComplex128Inc(dst []complex128, incDst, idst uintptr, x []complex64, n, incX, ix uintptr) {
+	for i := 0; i < int(n); i++ {
+		dst[idst] = complex128(x[ix])
+		ix += incX
+		idst += incDst
+	}
+}
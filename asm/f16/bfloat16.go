@@ -0,0 +1,121 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package f16
+
+import "math"
+
+// BFloat16 is a bfloat16 value, the high 16 bits of an IEEE 754 binary32,
+// held as its bit pattern.
+type BFloat16 uint16
+
+// BFloat16FromFloat32 returns the BFloat16 nearest to f, with ties rounded
+// to even. NaN stays NaN and is returned quieted.
+func BFloat16FromFloat32(f float32) BFloat16 {
+	b := math.Float32bits(f)
+	if b&0x7fffffff > 0x7f800000 {
+		return BFloat16(b>>16 | 0x40)
+	}
+	b += 0x7fff + (b>>16)&1
+	return BFloat16(b >> 16)
+}
+
+// Float32 returns b as a float32. The conversion is exact.
+func (b BFloat16) Float32() float32 {
+	return math.Float32frombits(uint32(b) << 16)
+}
+
+// BFloat16ToFloat32 is
+//  for i, v := range x {
+//  	dst[i] = v.Float32()
+//  }
+func BFloat16ToFloat32(dst []float32, x []BFloat16) {
+	for i, v := range x {
+		dst[i] = v.Float32()
+	}
+}
+
+// Float32ToBFloat16 is
+//  for i, v := range x {
+//  	dst[i] = BFloat16FromFloat32(v)
+//  }
+func Float32ToBFloat16(dst []BFloat16, x []float32) {
+	for i, v := range x {
+		dst[i] = BFloat16FromFloat32(v)
+	}
+}
+
+// BDotUnitary is
+//  for i, v := range x {
+//  	sum += y[i].Float32() * v.Float32()
+//  }
+//  return
+func BDotUnitary(x, y []BFloat16) (sum float32) {
+	for i, v := range x {
+		sum += float32(y[i].Float32() * v.Float32())
+	}
+	return
+}
+
+// BDotInc is
+//  for i := 0; i < int(n); i++ {
+//  	sum += y[iy].Float32() * x[ix].Float32()
+//  	ix += incX
+//  	iy += incY
+//  }
+//  return
+func BDotInc(x, y []BFloat16, n, incX, incY, ix, iy uintptr) (sum float32) {
+	for i := 0; i < int(n); i++ {
+		sum += float32(y[iy].Float32() * x[ix].Float32())
+		ix += incX
+		iy += incY
+	}
+	return
+}
+
+// BAxpyUnitary is
+//  for i, v := range x {
+//  	y[i] = BFloat16FromFloat32(y[i].Float32() + alpha*v.Float32())
+//  }
+func BAxpyUnitary(alpha float32, x, y []BFloat16) {
+	for i, v := range x {
+		y[i] = BFloat16FromFloat32(y[i].Float32() + float32(alpha*v.Float32()))
+	}
+}
+
+// BAxpyInc is
+//  for i := 0; i < int(n); i++ {
+//  	y[iy] = BFloat16FromFloat32(y[iy].Float32() + alpha*x[ix].Float32())
+//  	ix += incX
+//  	iy += incY
+//  }
+func BAxpyInc(alpha float32, x, y []BFloat16, n, incX, incY, ix, iy uintptr) {
+	for i := 0; i < int(n); i++ {
+		y[iy] = BFloat16FromFloat32(y[iy].Float32() + float32(alpha*x[ix].Float32()))
+		ix += incX
+		iy += incY
+	}
+}
+
+// BScalUnitary is
+//  for i, v := range x {
+//  	x[i] = BFloat16FromFloat32(alpha * v.Float32())
+//  }
+func BScalUnitary(alpha float32, x []BFloat16) {
+	for i, v := range x {
+		x[i] = BFloat16FromFloat32(alpha * v.Float32())
+	}
+}
+
+// BScalInc is
+//  for i := 0; i < int(n); i++ {
+//  	x[i*incX] = BFloat16FromFloat32(alpha * x[i*incX].Float32())
+//  }
+func BScalInc(alpha float32, x []BFloat16, n, incX uintptr) {
+	var ix uintptr
+	for i := 0; i < int(n); i++ {
+		x[ix] = BFloat16FromFloat32(alpha * x[ix].Float32())
+		ix += incX
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This repository is no longer maintained.
+// Development has moved to https://github.com/gonum/gonum.
+//
+// Package f16 provides IEEE 754 half-precision and bfloat16 vector primitives.
+//
+// Values are stored as uint16 bit patterns and widened to float32 for
+// arithmetic. Dot products accumulate in float32, and Axpy and Scal
+// compute each element in float32 before rounding the result back to
+// 16 bits. All narrowing conversions round to nearest, ties to even.
+//
+// On amd64 the Float16 conversion, Dot, Axpy and Scal kernels use the F16C
+// instruction set when the processor and operating system support it, and
+// fall back to pure Go otherwise. The BFloat16 kernels are pure Go.
+package f16
@@ -0,0 +1,67 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+#include "textflag.h"
+
+// VCVTPH2PS X0, X0
+#define VCVTPH2PS_X0_X0 BYTE $0xC4; BYTE $0xE2; BYTE $0x79; BYTE $0x13; BYTE $0xC0
+// VCVTPH2PS X1, X1
+#define VCVTPH2PS_X1_X1 BYTE $0xC4; BYTE $0xE2; BYTE $0x79; BYTE $0x13; BYTE $0xC9
+// VCVTPH2PS X2, X2
+#define VCVTPH2PS_X2_X2 BYTE $0xC4; BYTE $0xE2; BYTE $0x79; BYTE $0x13; BYTE $0xD2
+// VCVTPH2PS X3, X3
+#define VCVTPH2PS_X3_X3 BYTE $0xC4; BYTE $0xE2; BYTE $0x79; BYTE $0x13; BYTE $0xDB
+
+// func dotUnitaryF16C(x, y []Float16) (sum float32)
+TEXT ·dotUnitaryF16C(SB), NOSPLIT, $0
+	MOVQ x_base+0(FP), SI  // SI = &x
+	MOVQ y_base+24(FP), DI // DI = &y
+	MOVQ x_len+8(FP), CX   // CX = len(x)
+	PXOR X4, X4            // Clear accumulators
+	PXOR X5, X5
+	SHRQ $2, CX            // CX = len / 4
+	JZ   hdot_end          // if CX == 0 { return 0 }
+	XORQ AX, AX            // i = 0
+	MOVQ CX, BX
+	ANDQ $1, BX            // BX = (len / 4) % 2
+	SHRQ $1, CX            // CX = floor( len / 8 )
+	JZ   hdot_tail         // if CX == 0 { goto hdot_tail }
+
+hdot_loop: // Loop unrolled 8x   do {
+	MOVQ            (SI)(AX*2), X0 // X_i = x[i:i+4]
+	MOVQ            8(SI)(AX*2), X1
+	MOVQ            (DI)(AX*2), X2 // X_(i+2) = y[i:i+4]
+	MOVQ            8(DI)(AX*2), X3
+	VCVTPH2PS_X0_X0                // X_i = float32(X_i)
+	VCVTPH2PS_X1_X1
+	VCVTPH2PS_X2_X2
+	VCVTPH2PS_X3_X3
+	MULPS           X2, X0         // X_i *= X_(i+2)
+	MULPS           X3, X1
+	ADDPS           X0, X4         // X_(i+4) += X_i
+	ADDPS           X1, X5
+	ADDQ            $8, AX         // i += 8
+	LOOP            hdot_loop      // } while --CX > 0
+	ADDPS           X5, X4         // Combine accumulators
+	CMPQ            BX, $0         // if BX == 0 { goto hdot_end }
+	JE              hdot_end
+
+hdot_tail:
+	MOVQ            (SI)(AX*2), X0 // X0 = x[i:i+4]
+	MOVQ            (DI)(AX*2), X2 // X2 = y[i:i+4]
+	VCVTPH2PS_X0_X0                // X_i = float32(X_i)
+	VCVTPH2PS_X2_X2
+	MULPS           X2, X0         // X0 *= X2
+	ADDPS           X0, X4         // X4 += X0
+
+hdot_end:
+	MOVHLPS X4, X5        // X5 = { _, _, X4[3], X4[2] }
+	ADDPS   X5, X4        // X4[0:2] += X4[2:4]
+	MOVAPS  X4, X5
+	SHUFPS  $0x55, X5, X5 // X5 = { X4[1], X4[1], X4[1], X4[1] }
+	ADDSS   X5, X4        // X4[0] += X4[1]
+	MOVSS   X4, sum+48(FP)
+	RET
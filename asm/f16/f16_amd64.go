@@ -0,0 +1,92 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+package f16
+
+// useF16C reports whether the F16C kernels may be used. F16C instructions
+// are VEX encoded, so the operating system must also save AVX state.
+var useF16C = hasF16C()
+
+func hasF16C() bool {
+	const (
+		osxsave = 1 << 27
+		avx     = 1 << 28
+		f16c    = 1 << 29
+	)
+	_, _, ecx, _ := cpuid(1, 0)
+	if ecx&(osxsave|avx|f16c) != osxsave|avx|f16c {
+		return false
+	}
+	// Check that the OS has enabled XMM and YMM state saving.
+	eax, _ := xgetbv()
+	return eax&0x6 == 0x6
+}
+
+// Float16ToFloat32 is
+//  for i, v := range x {
+//  	dst[i] = v.Float32()
+//  }
+func Float16ToFloat32(dst []float32, x []Float16) {
+	if useF16C {
+		n := len(x) &^ 3
+		float16ToFloat32F16C(dst[:n], x[:n])
+		dst, x = dst[n:], x[n:]
+	}
+	float16ToFloat32(dst, x)
+}
+
+// Float32ToFloat16 is
+//  for i, v := range x {
+//  	dst[i] = Float16FromFloat32(v)
+//  }
+func Float32ToFloat16(dst []Float16, x []float32) {
+	if useF16C {
+		n := len(x) &^ 3
+		float32ToFloat16F16C(dst[:n], x[:n])
+		dst, x = dst[n:], x[n:]
+	}
+	float32ToFloat16(dst, x)
+}
+
+// DotUnitary is
+//  for i, v := range x {
+//  	sum += y[i].Float32() * v.Float32()
+//  }
+//  return
+func DotUnitary(x, y []Float16) (sum float32) {
+	if useF16C {
+		n := len(x) &^ 3
+		sum = dotUnitaryF16C(x[:n], y[:n])
+		x, y = x[n:], y[n:]
+	}
+	return sum + dotUnitary(x, y)
+}
+
+// AxpyUnitary is
+//  for i, v := range x {
+//  	y[i] = Float16FromFloat32(y[i].Float32() + alpha*v.Float32())
+//  }
+func AxpyUnitary(alpha float32, x, y []Float16) {
+	if useF16C {
+		n := len(x) &^ 3
+		axpyUnitaryF16C(alpha, x[:n], y[:n])
+		x, y = x[n:], y[n:]
+	}
+	axpyUnitary(alpha, x, y)
+}
+
+// ScalUnitary is
+//  for i, v := range x {
+//  	x[i] = Float16FromFloat32(alpha * v.Float32())
+//  }
+func ScalUnitary(alpha float32, x []Float16) {
+	if useF16C {
+		n := len(x) &^ 3
+		scalUnitaryF16C(alpha, x[:n])
+		x = x[n:]
+	}
+	scalUnitary(alpha, x)
+}
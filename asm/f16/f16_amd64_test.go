@@ -0,0 +1,21 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+package f16
+
+import "testing"
+
+// TestNoF16C checks the Go conversion and arithmetic kernels, which the
+// exported functions do not use for whole blocks when F16C is available.
+func TestNoF16C(t *testing.T) {
+	defer func(use bool) { useF16C = use }(useF16C)
+	useF16C = false
+
+	t.Run("Float16ToFloat32", TestFloat16ToFloat32)
+	t.Run("Float32ToFloat16", TestFloat32ToFloat16)
+	t.Run("Dot", TestDot)
+	t.Run("AxpyScal", TestAxpyScal)
+}
@@ -0,0 +1,128 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package f16
+
+import (
+	"math"
+	"testing"
+)
+
+func isNaN32(f float32) bool { return f != f }
+
+func TestFloat16Values(t *testing.T) {
+	for _, test := range []struct {
+		f    float32
+		want Float16
+	}{
+		{0, 0x0000},
+		{float32(math.Copysign(0, -1)), 0x8000},
+		{1, 0x3c00},
+		{-2, 0xc000},
+		{0.5, 0x3800},
+		{65504, 0x7bff},
+		{65519, 0x7bff},
+		{65520, 0x7c00}, // Tie between 65504 and 65536 rounds to even, which overflows.
+		{1e10, 0x7c00},
+		{float32(math.Inf(-1)), 0xfc00},
+		{1.0 / (1 << 14), 0x0400},     // Smallest normal.
+		{1.0 / (1 << 24), 0x0001},     // Smallest subnormal.
+		{1.0 / (1 << 25), 0x0000},     // Tie rounds to even zero.
+		{1.5 / (1 << 25), 0x0001},     // Above the tie.
+		{3.0 / (1 << 25), 0x0002},     // Tie rounds to even.
+		{1 + 1.0/(1<<11), 0x3c00},     // Tie rounds to even.
+		{1 + 3.0/(1<<11), 0x3c02},     // Tie rounds to even.
+		{1023.75 / (1 << 24), 0x0400}, // Rounds up from the largest subnormal.
+	} {
+		if got := Float16FromFloat32(test.f); got != test.want {
+			t.Errorf("unexpected Float16FromFloat32(%v): got %#04x want %#04x", test.f, got, test.want)
+		}
+	}
+}
+
+func TestFloat16RoundTrip(t *testing.T) {
+	for i := 0; i <= math.MaxUint16; i++ {
+		h := Float16(i)
+		f := h.Float32()
+		if h&0x7c00 == 0x7c00 && h&0x3ff != 0 {
+			if !isNaN32(f) {
+				t.Errorf("NaN %#04x converted to %v", i, f)
+			}
+			if got := Float16FromFloat32(f); got != h|0x200 {
+				t.Errorf("unexpected NaN round trip for %#04x: got %#04x", i, got)
+			}
+			continue
+		}
+		if got := Float16FromFloat32(f); got != h {
+			t.Errorf("unexpected round trip for %#04x via %v: got %#04x", i, f, got)
+		}
+	}
+}
+
+// TestFloat16Rounding checks rounding at and either side of the midpoint
+// between every pair of adjacent finite positive Float16 values.
+func TestFloat16Rounding(t *testing.T) {
+	for i := 0; i < 0x7bff; i++ {
+		lo, hi := Float16(i), Float16(i+1)
+		mid := (lo.Float32() + hi.Float32()) / 2
+		even := lo
+		if lo&1 == 1 {
+			even = hi
+		}
+		for _, test := range []struct {
+			f    float32
+			want Float16
+		}{
+			{mid, even},
+			{math.Nextafter32(mid, 0), lo},
+			{math.Nextafter32(mid, float32(math.Inf(1))), hi},
+		} {
+			if got := Float16FromFloat32(test.f); got != test.want {
+				t.Errorf("unexpected Float16FromFloat32(%v): got %#04x want %#04x", test.f, got, test.want)
+			}
+			if got := Float16FromFloat32(-test.f); got != test.want|0x8000 {
+				t.Errorf("unexpected Float16FromFloat32(%v): got %#04x want %#04x", -test.f, got, test.want|0x8000)
+			}
+		}
+	}
+}
+
+func TestBFloat16RoundTrip(t *testing.T) {
+	for i := 0; i <= math.MaxUint16; i++ {
+		b := BFloat16(i)
+		f := b.Float32()
+		if b&0x7f80 == 0x7f80 && b&0x7f != 0 {
+			if got := BFloat16FromFloat32(f); got != b|0x40 {
+				t.Errorf("unexpected NaN round trip for %#04x: got %#04x", i, got)
+			}
+			continue
+		}
+		if got := BFloat16FromFloat32(f); got != b {
+			t.Errorf("unexpected round trip for %#04x via %v: got %#04x", i, f, got)
+		}
+	}
+}
+
+func TestBFloat16Rounding(t *testing.T) {
+	for i := 0; i < 0x7f7f; i++ {
+		lo := BFloat16(i)
+		mid := math.Float32frombits(uint32(i)<<16 | 0x8000)
+		even := lo
+		if lo&1 == 1 {
+			even = lo + 1
+		}
+		for _, test := range []struct {
+			f    float32
+			want BFloat16
+		}{
+			{mid, even},
+			{math.Nextafter32(mid, 0), lo},
+			{math.Nextafter32(mid, float32(math.Inf(1))), lo + 1},
+		} {
+			if got := BFloat16FromFloat32(test.f); got != test.want {
+				t.Errorf("unexpected BFloat16FromFloat32(%v): got %#04x want %#04x", test.f, got, test.want)
+			}
+		}
+	}
+}
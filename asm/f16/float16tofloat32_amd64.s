@@ -0,0 +1,49 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+#include "textflag.h"
+
+// VCVTPH2PS X0, X0
+#define VCVTPH2PS_X0_X0 BYTE $0xC4; BYTE $0xE2; BYTE $0x79; BYTE $0x13; BYTE $0xC0
+// VCVTPH2PS X1, X1
+#define VCVTPH2PS_X1_X1 BYTE $0xC4; BYTE $0xE2; BYTE $0x79; BYTE $0x13; BYTE $0xC9
+// VCVTPH2PS X2, X2
+#define VCVTPH2PS_X2_X2 BYTE $0xC4; BYTE $0xE2; BYTE $0x79; BYTE $0x13; BYTE $0xD2
+// VCVTPH2PS X3, X3
+#define VCVTPH2PS_X3_X3 BYTE $0xC4; BYTE $0xE2; BYTE $0x79; BYTE $0x13; BYTE $0xDB
+
+// func float16ToFloat32F16C(dst []float32, x []Float16)
+TEXT ·float16ToFloat32F16C(SB), NOSPLIT, $0
+	MOVQ dst_base+0(FP), DI // DI = &dst
+	MOVQ x_base+24(FP), SI  // SI = &x
+	MOVQ x_len+32(FP), CX   // CX = len(x)
+	SHRQ $2, CX             // CX = len / 4
+	JZ   h2s_end            // if CX == 0 { return }
+	XORQ AX, AX             // i = 0
+	MOVQ CX, BX
+	ANDQ $1, BX             // BX = (len / 4) % 2
+	SHRQ $1, CX             // CX = floor( len / 8 )
+	JZ   h2s_tail           // if CX == 0 { goto h2s_tail }
+
+h2s_loop: // Loop unrolled 8x   do {
+	MOVQ            (SI)(AX*2), X0 // X_i = x[i:i+4]
+	MOVQ            8(SI)(AX*2), X1
+	VCVTPH2PS_X0_X0                // X_i = float32(X_i)
+	VCVTPH2PS_X1_X1
+	MOVUPS          X0, (DI)(AX*4) // dst[i:i+4] = X_i
+	MOVUPS          X1, 16(DI)(AX*4)
+	ADDQ            $8, AX         // i += 8
+	LOOP            h2s_loop       // } while --CX > 0
+	CMPQ            BX, $0         // if BX == 0 { return }
+	JE              h2s_end
+
+h2s_tail:
+	MOVQ            (SI)(AX*2), X0 // X0 = x[i:i+4]
+	VCVTPH2PS_X0_X0                // X0 = float32(X0)
+	MOVUPS          X0, (DI)(AX*4) // dst[i:i+4] = X0
+
+h2s_end:
+	RET
@@ -0,0 +1,45 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+#include "textflag.h"
+
+// VCVTPS2PH $0, X0, X0
+#define VCVTPS2PH_X0_X0 BYTE $0xC4; BYTE $0xE3; BYTE $0x79; BYTE $0x1D; BYTE $0xC0; BYTE $0x00
+// VCVTPS2PH $0, X1, X1
+#define VCVTPS2PH_X1_X1 BYTE $0xC4; BYTE $0xE3; BYTE $0x79; BYTE $0x1D; BYTE $0xC9; BYTE $0x00
+
+// func float32ToFloat16F16C(dst []Float16, x []float32)
+TEXT ·float32ToFloat16F16C(SB), NOSPLIT, $0
+	MOVQ dst_base+0(FP), DI // DI = &dst
+	MOVQ x_base+24(FP), SI  // SI = &x
+	MOVQ x_len+32(FP), CX   // CX = len(x)
+	SHRQ $2, CX             // CX = len / 4
+	JZ   s2h_end            // if CX == 0 { return }
+	XORQ AX, AX             // i = 0
+	MOVQ CX, BX
+	ANDQ $1, BX             // BX = (len / 4) % 2
+	SHRQ $1, CX             // CX = floor( len / 8 )
+	JZ   s2h_tail           // if CX == 0 { goto s2h_tail }
+
+s2h_loop: // Loop unrolled 8x   do {
+	MOVUPS          (SI)(AX*4), X0 // X_i = x[i:i+4]
+	MOVUPS          16(SI)(AX*4), X1
+	VCVTPS2PH_X0_X0                // X_i = Float16FromFloat32(X_i)
+	VCVTPS2PH_X1_X1
+	MOVQ            X0, (DI)(AX*2) // dst[i:i+4] = X_i
+	MOVQ            X1, 8(DI)(AX*2)
+	ADDQ            $8, AX         // i += 8
+	LOOP            s2h_loop       // } while --CX > 0
+	CMPQ            BX, $0         // if BX == 0 { return }
+	JE              s2h_end
+
+s2h_tail:
+	MOVUPS          (SI)(AX*4), X0 // X0 = x[i:i+4]
+	VCVTPS2PH_X0_X0                // X0 = Float16FromFloat32(X0)
+	MOVQ            X0, (DI)(AX*2) // dst[i:i+4] = X0
+
+s2h_end:
+	RET
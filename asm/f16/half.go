@@ -0,0 +1,82 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package f16
+
+import "math"
+
+// Float16 is an IEEE 754 binary16 value held as its bit pattern.
+type Float16 uint16
+
+// Float16FromFloat32 returns the Float16 nearest to f, with ties rounded
+// to even. Values too large in magnitude become ±Inf and NaN stays NaN,
+// keeping the sign and the high bits of the payload.
+func Float16FromFloat32(f float32) Float16 {
+	b := math.Float32bits(f)
+	sign := uint16(b>>16) & 0x8000
+	exp := int(b>>23) & 0xff
+	mant := b & 0x7fffff
+
+	if exp == 0xff {
+		if mant == 0 {
+			return Float16(sign | 0x7c00)
+		}
+		return Float16(sign | 0x7e00 | uint16(mant>>13))
+	}
+
+	e := exp - 127 + 15
+	switch {
+	case e >= 0x1f:
+		return Float16(sign | 0x7c00)
+	case e < -10:
+		return Float16(sign)
+	case e <= 0:
+		// The result is subnormal, or rounds up to the smallest normal.
+		m := mant | 0x800000
+		shift := uint(14 - e)
+		h := m >> shift
+		rem := m & (1<<shift - 1)
+		half := uint32(1) << (shift - 1)
+		if rem > half || (rem == half && h&1 == 1) {
+			h++
+		}
+		return Float16(sign | uint16(h))
+	}
+
+	// A carry out of the mantissa correctly increments the exponent,
+	// and may overflow to ±Inf.
+	h := uint32(e)<<10 | mant>>13
+	rem := mant & 0x1fff
+	if rem > 0x1000 || (rem == 0x1000 && h&1 == 1) {
+		h++
+	}
+	return Float16(sign | uint16(h))
+}
+
+// Float32 returns h as a float32. The conversion is exact, except that
+// a signaling NaN is returned quieted.
+func (h Float16) Float32() float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := uint32(h>>10) & 0x1f
+	mant := uint32(h & 0x3ff)
+
+	switch exp {
+	case 0x1f:
+		if mant != 0 {
+			mant |= 0x200
+		}
+		return math.Float32frombits(sign | 0x7f800000 | mant<<13)
+	case 0:
+		if mant == 0 {
+			return math.Float32frombits(sign)
+		}
+		e := uint32(127 - 15 + 1)
+		for mant&0x400 == 0 {
+			mant <<= 1
+			e--
+		}
+		return math.Float32frombits(sign | e<<23 | (mant&0x3ff)<<13)
+	}
+	return math.Float32frombits(sign | (exp+127-15)<<23 | mant<<13)
+}
@@ -0,0 +1,163 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18
+// +build go1.18
+
+package f16
+
+import (
+	"testing"
+
+	"github.com/gonum/internal/asm/internal/asmtest"
+)
+
+// benchKernel benchmarks a kernel with vectors allocated by setup.
+type benchKernel struct {
+	name  string
+	incs  []int
+	cost  asmtest.Cost
+	setup func(n, inc int) func()
+}
+
+// BenchmarkKernels benchmarks every kernel over a range of lengths and
+// increments, reporting GFLOP/s and GB/s.
+func BenchmarkKernels(b *testing.B) {
+	for _, k := range benchKernels {
+		b.Run(k.name, func(b *testing.B) {
+			asmtest.Bench(b, k.incs, k.cost, k.setup)
+		})
+	}
+}
+
+// benchVector returns a vector of n elements with increment inc for a
+// benchmark. All of its elements are one.
+func benchVector[T any](n, inc int, one T) []T {
+	if inc < 0 {
+		inc = -inc
+	}
+	var span int
+	if n > 0 {
+		span = (n-1)*inc + 1
+	}
+	x := make([]T, span)
+	for i := range x {
+		x[i] = one
+	}
+	return x
+}
+
+// kernelSink receives the results of the benchmarked reductions.
+var kernelSink float32
+
+var benchKernels = []benchKernel{
+	{name: "DotUnitary", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 2, Bytes: 2 * 2}, setup: func(n, _ int) func() {
+		x := benchVector(n, 1, Float16FromFloat32(1))
+		y := benchVector(n, 1, Float16FromFloat32(1))
+		return func() {
+			kernelSink = DotUnitary(x, y)
+		}
+	}},
+	{name: "DotInc", incs: asmtest.BenchIncs, cost: asmtest.Cost{Flops: 2, Bytes: 2 * 2}, setup: func(n, inc int) func() {
+		x := benchVector(n, inc, Float16FromFloat32(1))
+		y := benchVector(n, -inc, Float16FromFloat32(1))
+		return func() {
+			kernelSink = DotInc(x, y, uintptr(n), uintptr(inc), uintptr(-inc), uintptr(asmtest.Start(n, inc)), uintptr(asmtest.Start(n, -inc)))
+		}
+	}},
+	{name: "AxpyUnitary", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 2, Bytes: 3 * 2}, setup: func(n, _ int) func() {
+		x := benchVector(n, 1, Float16FromFloat32(1))
+		y := benchVector(n, 1, Float16FromFloat32(1))
+		return func() {
+			AxpyUnitary(1, x, y)
+		}
+	}},
+	{name: "AxpyInc", incs: asmtest.BenchIncs, cost: asmtest.Cost{Flops: 2, Bytes: 3 * 2}, setup: func(n, inc int) func() {
+		x := benchVector(n, inc, Float16FromFloat32(1))
+		y := benchVector(n, -inc, Float16FromFloat32(1))
+		return func() {
+			AxpyInc(1, x, y, uintptr(n), uintptr(inc), uintptr(-inc), uintptr(asmtest.Start(n, inc)), uintptr(asmtest.Start(n, -inc)))
+		}
+	}},
+	{name: "ScalUnitary", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 1, Bytes: 2 * 2}, setup: func(n, _ int) func() {
+		x := benchVector(n, 1, Float16FromFloat32(1))
+		return func() {
+			ScalUnitary(1, x)
+		}
+	}},
+	{name: "ScalInc", incs: asmtest.BenchPosIncs, cost: asmtest.Cost{Flops: 1, Bytes: 2 * 2}, setup: func(n, inc int) func() {
+		x := benchVector(n, inc, Float16FromFloat32(1))
+		return func() {
+			ScalInc(1, x, uintptr(n), uintptr(inc))
+		}
+	}},
+	{name: "Float16ToFloat32", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Bytes: 2 + 4}, setup: func(n, _ int) func() {
+		x := benchVector(n, 1, Float16FromFloat32(1))
+		dst := make([]float32, n)
+		return func() {
+			Float16ToFloat32(dst, x)
+		}
+	}},
+	{name: "Float32ToFloat16", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Bytes: 4 + 2}, setup: func(n, _ int) func() {
+		x := benchVector(n, 1, float32(1))
+		dst := make([]Float16, n)
+		return func() {
+			Float32ToFloat16(dst, x)
+		}
+	}},
+	{name: "BDotUnitary", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 2, Bytes: 2 * 2}, setup: func(n, _ int) func() {
+		x := benchVector(n, 1, BFloat16FromFloat32(1))
+		y := benchVector(n, 1, BFloat16FromFloat32(1))
+		return func() {
+			kernelSink = BDotUnitary(x, y)
+		}
+	}},
+	{name: "BDotInc", incs: asmtest.BenchIncs, cost: asmtest.Cost{Flops: 2, Bytes: 2 * 2}, setup: func(n, inc int) func() {
+		x := benchVector(n, inc, BFloat16FromFloat32(1))
+		y := benchVector(n, -inc, BFloat16FromFloat32(1))
+		return func() {
+			kernelSink = BDotInc(x, y, uintptr(n), uintptr(inc), uintptr(-inc), uintptr(asmtest.Start(n, inc)), uintptr(asmtest.Start(n, -inc)))
+		}
+	}},
+	{name: "BAxpyUnitary", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 2, Bytes: 3 * 2}, setup: func(n, _ int) func() {
+		x := benchVector(n, 1, BFloat16FromFloat32(1))
+		y := benchVector(n, 1, BFloat16FromFloat32(1))
+		return func() {
+			BAxpyUnitary(1, x, y)
+		}
+	}},
+	{name: "BAxpyInc", incs: asmtest.BenchIncs, cost: asmtest.Cost{Flops: 2, Bytes: 3 * 2}, setup: func(n, inc int) func() {
+		x := benchVector(n, inc, BFloat16FromFloat32(1))
+		y := benchVector(n, -inc, BFloat16FromFloat32(1))
+		return func() {
+			BAxpyInc(1, x, y, uintptr(n), uintptr(inc), uintptr(-inc), uintptr(asmtest.Start(n, inc)), uintptr(asmtest.Start(n, -inc)))
+		}
+	}},
+	{name: "BScalUnitary", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 1, Bytes: 2 * 2}, setup: func(n, _ int) func() {
+		x := benchVector(n, 1, BFloat16FromFloat32(1))
+		return func() {
+			BScalUnitary(1, x)
+		}
+	}},
+	{name: "BScalInc", incs: asmtest.BenchPosIncs, cost: asmtest.Cost{Flops: 1, Bytes: 2 * 2}, setup: func(n, inc int) func() {
+		x := benchVector(n, inc, BFloat16FromFloat32(1))
+		return func() {
+			BScalInc(1, x, uintptr(n), uintptr(inc))
+		}
+	}},
+	{name: "BFloat16ToFloat32", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Bytes: 2 + 4}, setup: func(n, _ int) func() {
+		x := benchVector(n, 1, BFloat16FromFloat32(1))
+		dst := make([]float32, n)
+		return func() {
+			BFloat16ToFloat32(dst, x)
+		}
+	}},
+	{name: "Float32ToBFloat16", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Bytes: 4 + 2}, setup: func(n, _ int) func() {
+		x := benchVector(n, 1, float32(1))
+		dst := make([]BFloat16, n)
+		return func() {
+			Float32ToBFloat16(dst, x)
+		}
+	}},
+}
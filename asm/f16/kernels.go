@@ -0,0 +1,82 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package f16
+
+// The unexported functions below are the pure Go Float16 kernels. They are
+// called directly by the exported functions when no faster implementation
+// is available, and complete the tails left by the vector implementations.
+
+func float16ToFloat32(dst []float32, x []Float16) {
+	for i, v := range x {
+		dst[i] = v.Float32()
+	}
+}
+
+func float32ToFloat16(dst []Float16, x []float32) {
+	for i, v := range x {
+		dst[i] = Float16FromFloat32(v)
+	}
+}
+
+func dotUnitary(x, y []Float16) (sum float32) {
+	for i, v := range x {
+		sum += float32(y[i].Float32() * v.Float32())
+	}
+	return
+}
+
+func axpyUnitary(alpha float32, x, y []Float16) {
+	for i, v := range x {
+		y[i] = Float16FromFloat32(y[i].Float32() + float32(alpha*v.Float32()))
+	}
+}
+
+func scalUnitary(alpha float32, x []Float16) {
+	for i, v := range x {
+		x[i] = Float16FromFloat32(alpha * v.Float32())
+	}
+}
+
+// DotInc is
+//  for i := 0; i < int(n); i++ {
+//  	sum += y[iy].Float32() * x[ix].Float32()
+//  	ix += incX
+//  	iy += incY
+//  }
+//  return
+func DotInc(x, y []Float16, n, incX, incY, ix, iy uintptr) (sum float32) {
+	for i := 0; i < int(n); i++ {
+		sum += float32(y[iy].Float32() * x[ix].Float32())
+		ix += incX
+		iy += incY
+	}
+	return
+}
+
+// AxpyInc is
+//  for i := 0; i < int(n); i++ {
+//  	y[iy] = Float16FromFloat32(y[iy].Float32() + alpha*x[ix].Float32())
+//  	ix += incX
+//  	iy += incY
+//  }
+func AxpyInc(alpha float32, x, y []Float16, n, incX, incY, ix, iy uintptr) {
+	for i := 0; i < int(n); i++ {
+		y[iy] = Float16FromFloat32(y[iy].Float32() + float32(alpha*x[ix].Float32()))
+		ix += incX
+		iy += incY
+	}
+}
+
+// ScalInc is
+//  for i := 0; i < int(n); i++ {
+//  	x[i*incX] = Float16FromFloat32(alpha * x[i*incX].Float32())
+//  }
+func ScalInc(alpha float32, x []Float16, n, incX uintptr) {
+	var ix uintptr
+	for i := 0; i < int(n); i++ {
+		x[ix] = Float16FromFloat32(alpha * x[ix].Float32())
+		ix += incX
+	}
+}
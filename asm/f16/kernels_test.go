@@ -0,0 +1,221 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package f16
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+var kernelLens = []int{0, 1, 2, 3, 4, 5, 7, 8, 9, 11, 12, 13, 15, 16, 17, 31, 32, 33, 100}
+
+func randHalf(rnd *rand.Rand, n int) []Float16 {
+	x := make([]Float16, n)
+	for i := range x {
+		x[i] = Float16FromFloat32(float32(rnd.NormFloat64()))
+	}
+	return x
+}
+
+func randBHalf(rnd *rand.Rand, n int) []BFloat16 {
+	x := make([]BFloat16, n)
+	for i := range x {
+		x[i] = BFloat16FromFloat32(float32(rnd.NormFloat64()))
+	}
+	return x
+}
+
+func sameBits32(a, b float32) bool {
+	return math.Float32bits(a) == math.Float32bits(b)
+}
+
+func TestFloat16ToFloat32(t *testing.T) {
+	x := make([]Float16, math.MaxUint16+1)
+	for i := range x {
+		x[i] = Float16(i)
+	}
+	for _, n := range append(kernelLens, len(x)) {
+		dst := make([]float32, n+2)
+		dst[n], dst[n+1] = -1, -1
+		Float16ToFloat32(dst[:n], x[:n])
+		for i, v := range x[:n] {
+			if !sameBits32(dst[i], v.Float32()) {
+				t.Errorf("n=%d: unexpected Float16ToFloat32 result for %#04x: got %#08x want %#08x",
+					n, v, math.Float32bits(dst[i]), math.Float32bits(v.Float32()))
+			}
+		}
+		if dst[n] != -1 || dst[n+1] != -1 {
+			t.Errorf("n=%d: Float16ToFloat32 wrote past the end of dst", n)
+		}
+	}
+}
+
+func TestFloat32ToFloat16(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	x := make([]float32, 1<<16)
+	for i := range x {
+		switch i % 3 {
+		case 0:
+			x[i] = math.Float32frombits(rnd.Uint32())
+		case 1:
+			x[i] = math.Float32frombits(uint32(rnd.Intn(0x10000)) + 0x33000000) // Subnormal results.
+		default:
+			x[i] = float32(rnd.NormFloat64() * 1e4)
+		}
+	}
+	for _, n := range append(kernelLens, len(x)) {
+		dst := make([]Float16, n+2)
+		dst[n], dst[n+1] = 0xffff, 0xffff
+		Float32ToFloat16(dst[:n], x[:n])
+		for i, v := range x[:n] {
+			if want := Float16FromFloat32(v); dst[i] != want {
+				t.Errorf("n=%d: unexpected Float32ToFloat16 result for %#08x: got %#04x want %#04x",
+					n, math.Float32bits(v), dst[i], want)
+			}
+		}
+		if dst[n] != 0xffff || dst[n+1] != 0xffff {
+			t.Errorf("n=%d: Float32ToFloat16 wrote past the end of dst", n)
+		}
+	}
+}
+
+func TestDot(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range kernelLens {
+		for _, inc := range []uintptr{1, 2, 3} {
+			x, y := randHalf(rnd, n*int(inc)), randHalf(rnd, n*int(inc))
+			var want, scale float64
+			for i := 0; i < n; i++ {
+				p := float64(x[i].Float32()) * float64(y[i].Float32())
+				want += p
+				scale += math.Abs(p)
+			}
+			tol := 1e-6 * scale
+			if got := DotUnitary(x[:n], y[:n]); math.Abs(float64(got)-want) > tol {
+				t.Errorf("n=%d: unexpected DotUnitary result: got %v want %v", n, got, want)
+			}
+
+			want, scale = 0, 0
+			for i := 0; i < n; i++ {
+				p := float64(x[i*int(inc)].Float32()) * float64(y[i*int(inc)].Float32())
+				want += p
+				scale += math.Abs(p)
+			}
+			tol = 1e-6 * scale
+			if got := DotInc(x, y, uintptr(n), inc, inc, 0, 0); math.Abs(float64(got)-want) > tol {
+				t.Errorf("n=%d inc=%d: unexpected DotInc result: got %v want %v", n, inc, got, want)
+			}
+
+			bx, by := randBHalf(rnd, n*int(inc)), randBHalf(rnd, n*int(inc))
+			want, scale = 0, 0
+			for i := 0; i < n; i++ {
+				p := float64(bx[i*int(inc)].Float32()) * float64(by[i*int(inc)].Float32())
+				want += p
+				scale += math.Abs(p)
+			}
+			tol = 1e-6 * scale
+			if got := BDotInc(bx, by, uintptr(n), inc, inc, 0, 0); math.Abs(float64(got)-want) > tol {
+				t.Errorf("n=%d inc=%d: unexpected BDotInc result: got %v want %v", n, inc, got, want)
+			}
+			if inc == 1 {
+				if got := BDotUnitary(bx, by); math.Abs(float64(got)-want) > tol {
+					t.Errorf("n=%d: unexpected BDotUnitary result: got %v want %v", n, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestAxpyScal(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range kernelLens {
+		for _, inc := range []struct{ x, y uintptr }{{1, 1}, {2, 3}, {3, 1}} {
+			alpha := float32(rnd.NormFloat64())
+			prefix := fmt.Sprintf("n=%d incX=%d incY=%d alpha=%v", n, inc.x, inc.y, alpha)
+
+			x, y := randHalf(rnd, n*int(inc.x)), randHalf(rnd, n*int(inc.y)+1)
+			y[len(y)-1] = 0x7e01 // Guard.
+			want := make([]Float16, len(y))
+			copy(want, y)
+			for i := 0; i < n; i++ {
+				w := &want[i*int(inc.y)]
+				*w = Float16FromFloat32(w.Float32() + float32(alpha*x[i*int(inc.x)].Float32()))
+			}
+			got := make([]Float16, len(y))
+			copy(got, y)
+			AxpyInc(alpha, x, got, uintptr(n), inc.x, inc.y, 0, 0)
+			checkHalves(t, prefix+" AxpyInc", got, want)
+			if inc.x == 1 && inc.y == 1 {
+				copy(got, y)
+				AxpyUnitary(alpha, x[:n], got[:n])
+				checkHalves(t, prefix+" AxpyUnitary", got, want)
+			}
+
+			copy(want, y)
+			for i := 0; i < n; i++ {
+				w := &want[i*int(inc.y)]
+				*w = Float16FromFloat32(alpha * w.Float32())
+			}
+			copy(got, y)
+			ScalInc(alpha, got, uintptr(n), inc.y)
+			checkHalves(t, prefix+" ScalInc", got, want)
+			if inc.y == 1 {
+				copy(got, y)
+				ScalUnitary(alpha, got[:n])
+				checkHalves(t, prefix+" ScalUnitary", got, want)
+			}
+
+			bx, by := randBHalf(rnd, n*int(inc.x)), randBHalf(rnd, n*int(inc.y)+1)
+			by[len(by)-1] = 0x7fc1 // Guard.
+			bwant := make([]BFloat16, len(by))
+			copy(bwant, by)
+			for i := 0; i < n; i++ {
+				w := &bwant[i*int(inc.y)]
+				*w = BFloat16FromFloat32(w.Float32() + float32(alpha*bx[i*int(inc.x)].Float32()))
+			}
+			bgot := make([]BFloat16, len(by))
+			copy(bgot, by)
+			BAxpyInc(alpha, bx, bgot, uintptr(n), inc.x, inc.y, 0, 0)
+			checkBHalves(t, prefix+" BAxpyInc", bgot, bwant)
+			if inc.x == 1 && inc.y == 1 {
+				copy(bgot, by)
+				BAxpyUnitary(alpha, bx[:n], bgot[:n])
+				checkBHalves(t, prefix+" BAxpyUnitary", bgot, bwant)
+			}
+
+			copy(bwant, by)
+			for i := 0; i < n; i++ {
+				w := &bwant[i*int(inc.y)]
+				*w = BFloat16FromFloat32(alpha * w.Float32())
+			}
+			copy(bgot, by)
+			BScalInc(alpha, bgot, uintptr(n), inc.y)
+			checkBHalves(t, prefix+" BScalInc", bgot, bwant)
+			if inc.y == 1 {
+				copy(bgot, by)
+				BScalUnitary(alpha, bgot[:n])
+				checkBHalves(t, prefix+" BScalUnitary", bgot, bwant)
+			}
+		}
+	}
+}
+
+func checkHalves(t *testing.T, prefix string, got, want []Float16) {
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("%s: unexpected value at %d: got %#04x want %#04x", prefix, i, got[i], want[i])
+		}
+	}
+}
+
+func checkBHalves(t *testing.T, prefix string, got, want []BFloat16) {
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("%s: unexpected value at %d: got %#04x want %#04x", prefix, i, got[i], want[i])
+		}
+	}
+}
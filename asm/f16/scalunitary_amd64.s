@@ -0,0 +1,57 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+#include "textflag.h"
+
+// VCVTPH2PS X0, X0
+#define VCVTPH2PS_X0_X0 BYTE $0xC4; BYTE $0xE2; BYTE $0x79; BYTE $0x13; BYTE $0xC0
+// VCVTPH2PS X1, X1
+#define VCVTPH2PS_X1_X1 BYTE $0xC4; BYTE $0xE2; BYTE $0x79; BYTE $0x13; BYTE $0xC9
+
+// VCVTPS2PH $0, X0, X0
+#define VCVTPS2PH_X0_X0 BYTE $0xC4; BYTE $0xE3; BYTE $0x79; BYTE $0x1D; BYTE $0xC0; BYTE $0x00
+// VCVTPS2PH $0, X1, X1
+#define VCVTPS2PH_X1_X1 BYTE $0xC4; BYTE $0xE3; BYTE $0x79; BYTE $0x1D; BYTE $0xC9; BYTE $0x00
+
+// func scalUnitaryF16C(alpha float32, x []Float16)
+TEXT ·scalUnitaryF16C(SB), NOSPLIT, $0
+	MOVQ   x_base+8(FP), SI // SI = &x
+	MOVQ   x_len+16(FP), CX // CX = len(x)
+	SHRQ   $2, CX           // CX = len / 4
+	JZ     hscal_end        // if CX == 0 { return }
+	MOVSS  alpha+0(FP), X4
+	SHUFPS $0, X4, X4       // X4 = { a, a, a, a }
+	XORQ   AX, AX           // i = 0
+	MOVQ   CX, BX
+	ANDQ   $1, BX           // BX = (len / 4) % 2
+	SHRQ   $1, CX           // CX = floor( len / 8 )
+	JZ     hscal_tail       // if CX == 0 { goto hscal_tail }
+
+hscal_loop: // Loop unrolled 8x   do {
+	MOVQ            (SI)(AX*2), X0 // X_i = x[i:i+4]
+	MOVQ            8(SI)(AX*2), X1
+	VCVTPH2PS_X0_X0                // X_i = float32(X_i)
+	VCVTPH2PS_X1_X1
+	MULPS           X4, X0         // X_i *= a
+	MULPS           X4, X1
+	VCVTPS2PH_X0_X0                // X_i = Float16FromFloat32(X_i)
+	VCVTPS2PH_X1_X1
+	MOVQ            X0, (SI)(AX*2) // x[i:i+4] = X_i
+	MOVQ            X1, 8(SI)(AX*2)
+	ADDQ            $8, AX         // i += 8
+	LOOP            hscal_loop     // } while --CX > 0
+	CMPQ            BX, $0         // if BX == 0 { return }
+	JE              hscal_end
+
+hscal_tail:
+	MOVQ            (SI)(AX*2), X0 // X0 = x[i:i+4]
+	VCVTPH2PS_X0_X0                // X0 = float32(X0)
+	MULPS           X4, X0         // X0 *= a
+	VCVTPS2PH_X0_X0                // X0 = Float16FromFloat32(X0)
+	MOVQ            X0, (SI)(AX*2) // x[i:i+4] = X0
+
+hscal_end:
+	RET
@@ -0,0 +1,46 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+package f16
+
+// cpuid executes the CPUID instruction with the given EAX and ECX inputs.
+func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+
+// xgetbv returns the contents of the XCR0 extended control register.
+func xgetbv() (eax, edx uint32)
+
+// The F16C kernels below require len(x) to be a multiple of 4.
+
+// float16ToFloat32F16C is
+//  for i, v := range x {
+//  	dst[i] = v.Float32()
+//  }
+func float16ToFloat32F16C(dst []float32, x []Float16)
+
+// float32ToFloat16F16C is
+//  for i, v := range x {
+//  	dst[i] = Float16FromFloat32(v)
+//  }
+func float32ToFloat16F16C(dst []Float16, x []float32)
+
+// dotUnitaryF16C is
+//  for i, v := range x {
+//  	sum += y[i].Float32() * v.Float32()
+//  }
+//  return
+func dotUnitaryF16C(x, y []Float16) (sum float32)
+
+// axpyUnitaryF16C is
+//  for i, v := range x {
+//  	y[i] = Float16FromFloat32(y[i].Float32() + alpha*v.Float32())
+//  }
+func axpyUnitaryF16C(alpha float32, x, y []Float16)
+
+// scalUnitaryF16C is
+//  for i, v := range x {
+//  	x[i] = Float16FromFloat32(alpha * v.Float32())
+//  }
+func scalUnitaryF16C(alpha float32, x []Float16)
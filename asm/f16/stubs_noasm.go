@@ -0,0 +1,48 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !amd64 noasm appengine
+
+package f16
+
+// Float16ToFloat32 is
+//  for i, v := range x {
+//  	dst[i] = v.Float32()
+//  }
+func Float16ToFloat32(dst []float32, x []Float16) {
+	float16ToFloat32(dst, x)
+}
+
+// Float32ToFloat16 is
+//  for i, v := range x {
+//  	dst[i] = Float16FromFloat32(v)
+//  }
+func Float32ToFloat16(dst []Float16, x []float32) {
+	float32ToFloat16(dst, x)
+}
+
+// DotUnitary is
+//  for i, v := range x {
+//  	sum += y[i].Float32() * v.Float32()
+//  }
+//  return
+func DotUnitary(x, y []Float16) (sum float32) {
+	return dotUnitary(x, y)
+}
+
+// AxpyUnitary is
+//  for i, v := range x {
+//  	y[i] = Float16FromFloat32(y[i].Float32() + alpha*v.Float32())
+//  }
+func AxpyUnitary(alpha float32, x, y []Float16) {
+	axpyUnitary(alpha, x, y)
+}
+
+// ScalUnitary is
+//  for i, v := range x {
+//  	x[i] = Float16FromFloat32(alpha * v.Float32())
+//  }
+func ScalUnitary(alpha float32, x []Float16) {
+	scalUnitary(alpha, x)
+}
@@ -0,0 +1,86 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18
+// +build go1.18
+
+package f32
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/gonum/internal/asm/internal/asmtest"
+)
+
+// otherAccuracyTests are the accuracy tests for the kernels that are not
+// generated by asmgen.
+var otherAccuracyTests = []accuracyTest{
+	{name: "DdotUnitary", incs: asmtest.AccuracyUnitaryIncs, acc: asmtest.Accuracy{Cond: 1}, kernel: func(rnd *rand.Rand, n, _ int, acc asmtest.Accuracy) error {
+		x := asmtest.AccuracyVector[float32](rnd, n, 1)
+		y := asmtest.AccuracyVector[float32](rnd, n, 1)
+		return asmtest.CompareExact(DdotUnitary(x, y), asmtest.ExactDot(x, y), acc)
+	}},
+	{name: "DdotInc", incs: asmtest.AccuracyIncs, acc: asmtest.Accuracy{Cond: 1}, kernel: func(rnd *rand.Rand, n, inc int, acc asmtest.Accuracy) error {
+		x := asmtest.AccuracyVector[float32](rnd, n, inc)
+		y := asmtest.AccuracyVector[float32](rnd, n, -inc)
+		got := DdotInc(x, y, uintptr(n), uintptr(inc), uintptr(-inc), uintptr(asmtest.Start(n, inc)), uintptr(asmtest.Start(n, -inc)))
+		return asmtest.CompareExact(got, asmtest.ExactDot(asmtest.Elems(x, n, inc), asmtest.Elems(y, n, -inc)), acc)
+	}},
+	{name: "SdsdotUnitary", incs: asmtest.AccuracyUnitaryIncs, acc: asmtest.Accuracy{Cond: 1}, kernel: func(rnd *rand.Rand, n, _ int, acc asmtest.Accuracy) error {
+		x := asmtest.AccuracyVector[float32](rnd, n, 1)
+		y := asmtest.AccuracyVector[float32](rnd, n, 1)
+		alpha := asmtest.AccuracyVector[float32](rnd, 1, 1)
+		return asmtest.CompareExact(SdsdotUnitary(alpha[0], x, y), sdsdotExact(alpha[0], x, y), acc)
+	}},
+	{name: "SdsdotInc", incs: asmtest.AccuracyIncs, acc: asmtest.Accuracy{Cond: 1}, kernel: func(rnd *rand.Rand, n, inc int, acc asmtest.Accuracy) error {
+		x := asmtest.AccuracyVector[float32](rnd, n, inc)
+		y := asmtest.AccuracyVector[float32](rnd, n, -inc)
+		alpha := asmtest.AccuracyVector[float32](rnd, 1, 1)
+		got := SdsdotInc(alpha[0], x, y, uintptr(n), uintptr(inc), uintptr(-inc), uintptr(asmtest.Start(n, inc)), uintptr(asmtest.Start(n, -inc)))
+		return asmtest.CompareExact(got, sdsdotExact(alpha[0], asmtest.Elems(x, n, inc), asmtest.Elems(y, n, -inc)), acc)
+	}},
+	{name: "DsumUnitary", incs: asmtest.AccuracyUnitaryIncs, acc: asmtest.Accuracy{Cond: 1}, kernel: func(rnd *rand.Rand, n, _ int, acc asmtest.Accuracy) error {
+		x := asmtest.AccuracyVector[float32](rnd, n, 1)
+		return asmtest.CompareExact(DsumUnitary(x), asmtest.ExactSum(x), acc)
+	}},
+	{name: "DsumInc", incs: asmtest.AccuracyIncs, acc: asmtest.Accuracy{Cond: 1}, kernel: func(rnd *rand.Rand, n, inc int, acc asmtest.Accuracy) error {
+		x := asmtest.AccuracyVector[float32](rnd, n, inc)
+		return asmtest.CompareExact(DsumInc(x, uintptr(n), uintptr(inc), uintptr(asmtest.Start(n, inc))), asmtest.ExactSum(asmtest.Elems(x, n, inc)), acc)
+	}},
+	{name: "DasumUnitary", incs: asmtest.AccuracyUnitaryIncs, acc: asmtest.Accuracy{Cond: 1}, kernel: func(rnd *rand.Rand, n, _ int, acc asmtest.Accuracy) error {
+		x := asmtest.AccuracyVector[float32](rnd, n, 1)
+		return asmtest.CompareExact(DasumUnitary(x), asmtest.ExactSum(abs(x)), acc)
+	}},
+	{name: "DasumInc", incs: asmtest.AccuracyIncs, acc: asmtest.Accuracy{Cond: 1}, kernel: func(rnd *rand.Rand, n, inc int, acc asmtest.Accuracy) error {
+		x := asmtest.AccuracyVector[float32](rnd, n, inc)
+		return asmtest.CompareExact(DasumInc(x, uintptr(n), uintptr(inc), uintptr(asmtest.Start(n, inc))), asmtest.ExactSum(abs(asmtest.Elems(x, n, inc))), acc)
+	}},
+	{name: "Dnrm2Unitary", incs: asmtest.AccuracyUnitaryIncs, acc: asmtest.Accuracy{Cond: 1}, kernel: func(rnd *rand.Rand, n, _ int, acc asmtest.Accuracy) error {
+		x := asmtest.AccuracyVector[float32](rnd, n, 1)
+		return asmtest.CompareExact(Dnrm2Unitary(x), asmtest.ExactNorm(x), acc)
+	}},
+	{name: "Dnrm2Inc", incs: asmtest.AccuracyIncs, acc: asmtest.Accuracy{Cond: 1}, kernel: func(rnd *rand.Rand, n, inc int, acc asmtest.Accuracy) error {
+		x := asmtest.AccuracyVector[float32](rnd, n, inc)
+		return asmtest.CompareExact(Dnrm2Inc(x, uintptr(n), uintptr(inc), uintptr(asmtest.Start(n, inc))), asmtest.ExactNorm(asmtest.Elems(x, n, inc)), acc)
+	}},
+}
+
+// sdsdotExact returns the exact result of Sdsdot, a sum of len(x)+1
+// terms.
+func sdsdotExact(alpha float32, x, y []float32) *asmtest.Exact {
+	e := asmtest.ExactDot(x, y)
+	e.AddTerm(asmtest.Big(alpha))
+	e.Terms++
+	return e
+}
+
+// abs returns the absolute values of the elements of x.
+func abs(x []float32) []float32 {
+	a := make([]float32, len(x))
+	for i, v := range x {
+		a[i] = float32(math.Abs(float64(v)))
+	}
+	return a
+}
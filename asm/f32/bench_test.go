@@ -1,7 +1,9 @@
-// Copyright ©2016 The gonum Authors. All rights reserved.
+// Copyright ©2017 The gonum Authors. All rights reserved.
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
+// Code generated by asmgen from asm/internal/asmgen/templates.go; DO NOT EDIT.
+
 package f32
 
 import "testing"
@@ -0,0 +1,55 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !amd64 noasm appengine
+
+package f32
+
+// Float64ToFloat32 is
+//  for i, v := range x {
+//  	dst[i] = float32(v)
+//  }
+func Float64ToFloat32(dst []float32, x []float64) {
+	for i, v := range x {
+		dst[i] = float32(v)
+	}
+}
+
+// Float64ToFloat32Inc is
+//  for i := 0; i < int(n); i++ {
+//  	dst[idst] = float32(x[ix])
+//  	ix += incX
+//  	idst += incDst
+//  }
+func Float64ToFloat32Inc(dst []float32, incDst, idst uintptr, x []float64, n, incX, ix uintptr) {
+	for i := 0; i < int(n); i++ {
+		dst[idst] = float32(x[ix])
+		ix += incX
+		idst += incDst
+	}
+}
+
+// Float32ToFloat64 is
+//  for i, v := range x {
+//  	dst[i] = float64(v)
+//  }
+func Float32ToFloat64(dst []float64, x []float32) {
+	for i, v := range x {
+		dst[i] = float64(v)
+	}
+}
+
+// Float32ToFloat64Inc is
+//  for i := 0; i < int(n); i++ {
+//  	dst[idst] = float64(x[ix])
+//  	ix += incX
+//  	idst += incDst
+//  }
+func Float32ToFloat64Inc(dst []float64, incDst, idst uintptr, x []float32, n, incX, ix uintptr) {
+	for i := 0; i < int(n); i++ {
+		dst[idst] = float64(x[ix])
+		ix += incX
+		idst += incDst
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package f32
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// convSpecials are float64 values that exercise the rounding, overflow
+// and underflow behaviour of conversion to float32.
+var convSpecials = []float64{
+	0, math.Copysign(0, -1), 1, -1, math.Inf(1), math.Inf(-1), math.NaN(),
+	math.MaxFloat32, -math.MaxFloat32, 2 * math.MaxFloat32, math.MaxFloat64,
+	math.SmallestNonzeroFloat32, math.SmallestNonzeroFloat32 / 3, math.SmallestNonzeroFloat64,
+	1 + 1.0/(1<<24), 1 + 3.0/(1<<24), 1 + 1.0/(1<<24) + 1.0/(1<<40), // Ties and near-ties.
+	0.1, -1e-40, 3.4028235677973366e+38,
+}
+
+func convVec(rnd *rand.Rand, n int) []float64 {
+	x := make([]float64, n)
+	for i := range x {
+		if rnd.Intn(4) == 0 {
+			x[i] = convSpecials[rnd.Intn(len(convSpecials))]
+		} else {
+			x[i] = rnd.NormFloat64() * math.Pow(2, float64(rnd.Intn(300)-150))
+		}
+	}
+	return x
+}
+
+func sameFloat64(a, b float64) bool {
+	return math.Float64bits(a) == math.Float64bits(b) || (math.IsNaN(a) && math.IsNaN(b))
+}
+
+func sameFloat32(a, b float32) bool {
+	return math.Float32bits(a) == math.Float32bits(b) || (a != a && b != b)
+}
+
+func TestFloat64ToFloat32(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	const gd = -7
+	for _, n := range []int{0, 1, 2, 3, 7, 8, 9, 15, 16, 17, 63, 64, 65} {
+		for _, inc := range []struct{ x, dst uintptr }{{1, 1}, {2, 3}, {4, 1}} {
+			x := convVec(rnd, n)
+			if n >= len(convSpecials) {
+				copy(x, convSpecials)
+			}
+			prefix := fmt.Sprintf("n=%d incX=%d incDst=%d", n, inc.x, inc.dst)
+
+			dst := guardVector(make([]float32, n), gd, 3)
+			Float64ToFloat32(dst[3:3+n], x)
+			for i, v := range x {
+				if !sameFloat32(dst[3+i], float32(v)) {
+					t.Errorf("%s: unexpected Float64ToFloat32 result at %d for %v: got %v want %v", prefix, i, v, dst[3+i], float32(v))
+				}
+			}
+			if !isValidGuard(dst, gd, 3) {
+				t.Errorf("%s: Float64ToFloat32 guard violated: %v", prefix, dst)
+			}
+
+			gx := make([]float64, n*int(inc.x))
+			for i, v := range x {
+				gx[i*int(inc.x)] = v
+			}
+			dst = guardIncVector(make([]float32, n), gd, inc.dst, 3)
+			Float64ToFloat32Inc(dst[3:len(dst)-3], inc.dst, 0, gx, uintptr(n), inc.x, 0)
+			for i, v := range x {
+				if got := dst[3+i*int(inc.dst)]; !sameFloat32(got, float32(v)) {
+					t.Errorf("%s: unexpected Float64ToFloat32Inc result at %d for %v: got %v want %v", prefix, i, v, got, float32(v))
+				}
+			}
+			for i, v := range dst {
+				if j := i - 3; j >= 0 && j%int(inc.dst) == 0 && j/int(inc.dst) < n {
+					continue
+				}
+				if v != gd {
+					t.Errorf("%s: Float64ToFloat32Inc guard violated at %d: %v", prefix, i, v)
+				}
+			}
+		}
+	}
+}
+
+func TestFloat32ToFloat64(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	const gd = -7
+	for _, n := range []int{0, 1, 2, 3, 7, 8, 9, 15, 16, 17, 63, 64, 65} {
+		for _, inc := range []struct{ x, dst uintptr }{{1, 1}, {2, 3}, {4, 1}} {
+			x := make([]float32, n)
+			for i, v := range convVec(rnd, n) {
+				x[i] = float32(v)
+			}
+			prefix := fmt.Sprintf("n=%d incX=%d incDst=%d", n, inc.x, inc.dst)
+
+			dst := make([]float64, n+6)
+			for i := range dst {
+				dst[i] = gd
+			}
+			Float32ToFloat64(dst[3:3+n], guardVector(x, nan, 2)[2:2+n])
+			for i, v := range x {
+				if !sameFloat64(dst[3+i], float64(v)) {
+					t.Errorf("%s: unexpected Float32ToFloat64 result at %d: got %v want %v", prefix, i, dst[3+i], v)
+				}
+			}
+			for _, i := range []int{0, 1, 2, n + 3, n + 4, n + 5} {
+				if dst[i] != gd {
+					t.Errorf("%s: Float32ToFloat64 guard violated at %d", prefix, i)
+				}
+			}
+
+			gx := guardIncVector(x, nan, inc.x, 3)
+			dst = make([]float64, n*int(inc.dst)+6)
+			for i := range dst {
+				dst[i] = gd
+			}
+			Float32ToFloat64Inc(dst[3:len(dst)-3], inc.dst, 0, gx[3:len(gx)-3], uintptr(n), inc.x, 0)
+			for i := range dst {
+				want := float64(gd)
+				if j := i - 3; j >= 0 && j%int(inc.dst) == 0 && j/int(inc.dst) < n {
+					want = float64(x[j/int(inc.dst)])
+				}
+				if !sameFloat64(dst[i], want) {
+					t.Errorf("%s: unexpected Float32ToFloat64Inc value at %d: got %v want %v", prefix, i, dst[i], want)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+#include "textflag.h"
+
+// func DasumInc(x []float32, n, incX, ix uintptr) (sum float64)
+TEXT ·DasumInc(SB), NOSPLIT, $0
+	MOVQ    x_base+0(FP), SI    // SI = &x
+	MOVQ    n+24(FP), CX        // CX = n
+	MOVQ    ix+40(FP), R8       // R8 = ix
+	LEAQ    (SI)(R8*4), SI      // SI = &(x[ix])
+	MOVQ    incX+32(FP), R8     // R8 = incX
+	SHLQ    $2, R8              // R8 *= sizeof(float32)
+	PXOR    X0, X0              // Clear accumulators
+	PXOR    X1, X1
+	PXOR    X2, X2
+	PXOR    X3, X3
+	PCMPEQL X8, X8              // X8 = { 0x7FF..., 0x7FF... }
+	PSRLQ   $1, X8
+	CMPQ    CX, $0              // if n == 0 { return 0 }
+	JE      dasuminc_end
+	LEAQ    (R8)(R8*2), R9      // R9 = 3 * incX * sizeof(float32)
+	MOVQ    CX, BX
+	ANDQ    $3, BX              // BX = n % 4
+	SHRQ    $2, CX              // CX = floor( n / 4 )
+	JZ      dasuminc_tail_start // if CX == 0 { goto dasuminc_tail_start }
+
+dasuminc_loop: // Loop unrolled 4x   do {
+	CVTSS2SD (SI), X4       // X_i = float64(x[ix])
+	CVTSS2SD (SI)(R8*1), X5
+	CVTSS2SD (SI)(R8*2), X6
+	CVTSS2SD (SI)(R9*1), X7
+	ANDPD    X8, X4         // X_i = abs(X_i)
+	ANDPD    X8, X5
+	ANDPD    X8, X6
+	ANDPD    X8, X7
+	ADDSD    X4, X0         // X_j += X_i
+	ADDSD    X5, X1
+	ADDSD    X6, X2
+	ADDSD    X7, X3
+	LEAQ     (SI)(R8*4), SI // SI = &(SI[incX*4])
+	LOOP     dasuminc_loop  // } while --CX > 0
+	ADDSD    X1, X0         // Combine accumulators
+	ADDSD    X3, X2
+	ADDSD    X2, X0
+	CMPQ     BX, $0         // if BX == 0 { goto dasuminc_end }
+	JE       dasuminc_end
+
+dasuminc_tail_start:
+	MOVQ BX, CX
+
+dasuminc_tail: // do {
+	CVTSS2SD (SI), X4      // X4 = float64(x[ix])
+	ANDPD    X8, X4        // X_i = abs(X_i)
+	ADDSD    X4, X0        // sum += X4
+	ADDQ     R8, SI        // SI = &(SI[incX])
+	LOOP     dasuminc_tail // } while --CX > 0
+
+dasuminc_end:
+	MOVSD X0, sum+48(FP)
+	RET
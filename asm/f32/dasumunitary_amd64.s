@@ -0,0 +1,63 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+#include "textflag.h"
+
+// func DasumUnitary(x []float32) (sum float64)
+TEXT ·DasumUnitary(SB), NOSPLIT, $0
+	MOVQ    x_base+0(FP), SI // SI = &x
+	MOVQ    x_len+8(FP), CX  // CX = len(x)
+	XORQ    AX, AX           // i = 0
+	PXOR    X0, X0           // Clear accumulators
+	PXOR    X1, X1
+	PXOR    X2, X2
+	PXOR    X3, X3
+	PCMPEQL X8, X8           // X8 = { 0x7FF..., 0x7FF... }
+	PSRLQ   $1, X8
+	CMPQ    CX, $0           // if CX == 0 { return 0 }
+	JE      dasum_end
+	MOVQ    CX, BX
+	ANDQ    $7, BX           // BX = len % 8
+	SHRQ    $3, CX           // CX = floor( len / 8 )
+	JZ      dasum_tail_start // if CX == 0 { goto dasum_tail_start }
+
+dasum_loop: // Loop unrolled 8x   do {
+	CVTPS2PD (SI)(AX*4), X4 // X_i = float64(x[i:i+2])
+	CVTPS2PD 8(SI)(AX*4), X5
+	CVTPS2PD 16(SI)(AX*4), X6
+	CVTPS2PD 24(SI)(AX*4), X7
+	ANDPD    X8, X4         // X_i = abs(X_i)
+	ANDPD    X8, X5
+	ANDPD    X8, X6
+	ANDPD    X8, X7
+	ADDPD    X4, X0         // X_j += X_i
+	ADDPD    X5, X1
+	ADDPD    X6, X2
+	ADDPD    X7, X3
+	ADDQ     $8, AX         // i += 8
+	LOOP     dasum_loop     // } while --CX > 0
+	ADDPD    X1, X0         // Combine accumulators
+	ADDPD    X3, X2
+	ADDPD    X2, X0
+	CMPQ     BX, $0         // if BX == 0 { goto dasum_end }
+	JE       dasum_end
+
+dasum_tail_start:
+	MOVQ BX, CX
+
+dasum_tail: // do {
+	CVTSS2SD (SI)(AX*4), X4 // X4 = float64(x[i])
+	ANDPD    X8, X4         // X4 = abs(X4)
+	ADDSD    X4, X0         // sum += X4
+	INCQ     AX             // i++
+	LOOP     dasum_tail     // } while --CX > 0
+
+dasum_end:
+	MOVAPS   X0, X1
+	UNPCKHPD X1, X1 // X1 = { X0[1], X0[1] }
+	ADDSD    X1, X0 // X0[0] += X0[1]
+	MOVSD    X0, sum+24(FP)
+	RET
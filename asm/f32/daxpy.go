@@ -0,0 +1,31 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !amd64 noasm appengine
+
+package f32
+
+// DaxpyUnitary is
+//  for i, v := range x {
+//  	y[i] += alpha * float64(v)
+//  }
+func DaxpyUnitary(alpha float64, x []float32, y []float64) {
+	for i, v := range x {
+		y[i] += alpha * float64(v)
+	}
+}
+
+// DaxpyInc is
+//  for i := 0; i < int(n); i++ {
+//  	y[iy] += alpha * float64(x[ix])
+//  	ix += incX
+//  	iy += incY
+//  }
+func DaxpyInc(alpha float64, x []float32, y []float64, n, incX, incY, ix, iy uintptr) {
+	for i := 0; i < int(n); i++ {
+		y[iy] += alpha * float64(x[ix])
+		ix += incX
+		iy += incY
+	}
+}
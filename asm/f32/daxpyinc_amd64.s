@@ -0,0 +1,53 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+#include "textflag.h"
+
+// func DaxpyInc(alpha float64, x []float32, y []float64, n, incX, incY, ix, iy uintptr)
+TEXT ·DaxpyInc(SB), NOSPLIT, $0
+	MOVQ  n+56(FP), CX      // CX = n
+	CMPQ  CX, $0            // if n == 0 { return }
+	JE    daxpyi_end
+	MOVQ  x_base+8(FP), SI  // SI = &x
+	MOVQ  y_base+32(FP), DI // DI = &y
+	MOVQ  ix+80(FP), R8     // R8 = ix
+	MOVQ  iy+88(FP), R9     // R9 = iy
+	LEAQ  (SI)(R8*4), SI    // SI = &(x[ix])
+	LEAQ  (DI)(R9*8), DI    // DI = &(y[iy])
+	MOVQ  incX+64(FP), R8   // R8 = incX
+	SHLQ  $2, R8            // R8 *= sizeof(float32)
+	MOVQ  incY+72(FP), R9   // R9 = incY
+	SHLQ  $3, R9            // R9 *= sizeof(float64)
+	MOVSD alpha+0(FP), X0   // X0 = alpha
+	MOVSD X0, X1            // X1 = X0  // for pipelining
+	MOVQ  CX, BX
+	ANDQ  $1, BX            // BX = n % 2
+	SHRQ  $1, CX            // CX = floor( n / 2 )
+	JZ    daxpyi_tail       // if CX == 0 { goto daxpyi_tail }
+
+daxpyi_loop: // Loop unrolled 2x   do {
+	CVTSS2SD (SI), X2       // X_i = float64(x[ix])
+	CVTSS2SD (SI)(R8*1), X3
+	MULSD    X0, X2         // X_i *= a
+	MULSD    X1, X3
+	ADDSD    (DI), X2       // X_i += y[iy]
+	ADDSD    (DI)(R9*1), X3
+	MOVSD    X2, (DI)       // y[iy] = X_i
+	MOVSD    X3, (DI)(R9*1)
+	LEAQ     (SI)(R8*2), SI // SI = &(SI[incX*2])
+	LEAQ     (DI)(R9*2), DI // DI = &(DI[incY*2])
+	LOOP     daxpyi_loop    // } while --CX > 0
+	CMPQ     BX, $0         // if BX == 0 { return }
+	JE       daxpyi_end
+
+daxpyi_tail:
+	CVTSS2SD (SI), X2 // X2 = float64(x[ix])
+	MULSD    X0, X2   // X2 *= a
+	ADDSD    (DI), X2 // X2 += y[iy]
+	MOVSD    X2, (DI) // y[iy] = X2
+
+daxpyi_end:
+	RET
@@ -0,0 +1,53 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+#include "textflag.h"
+
+// func DaxpyUnitary(alpha float64, x []float32, y []float64)
+TEXT ·DaxpyUnitary(SB), NOSPLIT, $0
+	MOVQ   x_base+8(FP), SI  // SI = &x
+	MOVQ   y_base+32(FP), DI // DI = &y
+	MOVQ   x_len+16(FP), CX  // CX = len(x)
+	CMPQ   CX, $0            // if CX == 0 { return }
+	JE     daxpy_end
+	MOVSD  alpha+0(FP), X0
+	SHUFPD $0, X0, X0        // X0 = { a, a }
+	MOVAPS X0, X1            // Copy X0 to X1 for pipelining
+	XORQ   AX, AX            // i = 0
+	MOVQ   CX, BX
+	ANDQ   $3, BX            // BX = len % 4
+	SHRQ   $2, CX            // CX = floor( len / 4 )
+	JZ     daxpy_tail_start  // if CX == 0 { goto daxpy_tail_start }
+
+daxpy_loop: // Loop unrolled 4x   do {
+	CVTPS2PD (SI)(AX*4), X2 // X_i = float64(x[i:i+2])
+	CVTPS2PD 8(SI)(AX*4), X3
+	MOVUPS   (DI)(AX*8), X4 // X_(i+2) = y[i:i+2]
+	MOVUPS   16(DI)(AX*8), X5
+	MULPD    X0, X2         // X_i *= a
+	MULPD    X1, X3
+	ADDPD    X4, X2         // X_i += X_(i+2)
+	ADDPD    X5, X3
+	MOVUPS   X2, (DI)(AX*8) // y[i:i+2] = X_i
+	MOVUPS   X3, 16(DI)(AX*8)
+	ADDQ     $4, AX         // i += 4
+	LOOP     daxpy_loop     // } while --CX > 0
+	CMPQ     BX, $0         // if BX == 0 { return }
+	JE       daxpy_end
+
+daxpy_tail_start:
+	MOVQ BX, CX
+
+daxpy_tail: // do {
+	CVTSS2SD (SI)(AX*4), X2 // X2 = float64(x[i])
+	MULSD    X0, X2         // X2 *= a
+	ADDSD    (DI)(AX*8), X2 // X2 += y[i]
+	MOVSD    X2, (DI)(AX*8) // y[i] = X2
+	INCQ     AX             // i++
+	LOOP     daxpy_tail     // } while --CX > 0
+
+daxpy_end:
+	RET
@@ -0,0 +1,55 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+#include "textflag.h"
+
+// func DdotInc(x, y []float32, n, incX, incY, ix, iy uintptr) (sum float64)
+TEXT ·DdotInc(SB), NOSPLIT, $0
+	MOVQ x_base+0(FP), SI  // SI = &x
+	MOVQ y_base+24(FP), DI // DI = &y
+	MOVQ n+48(FP), CX      // CX = n
+	MOVQ ix+72(FP), R8     // R8 = ix
+	MOVQ iy+80(FP), R9     // R9 = iy
+	LEAQ (SI)(R8*4), SI    // SI = &(x[ix])
+	LEAQ (DI)(R9*4), DI    // DI = &(y[iy])
+	MOVQ incX+56(FP), R8   // R8 = incX
+	SHLQ $2, R8            // R8 *= sizeof(float32)
+	MOVQ incY+64(FP), R9   // R9 = incY
+	SHLQ $2, R9            // R9 *= sizeof(float32)
+	PXOR X0, X0            // Clear accumulators
+	PXOR X1, X1
+	CMPQ CX, $0            // if n == 0 { return 0 }
+	JE   ddoti_end
+	MOVQ CX, BX
+	ANDQ $1, BX            // BX = n % 2
+	SHRQ $1, CX            // CX = floor( n / 2 )
+	JZ   ddoti_tail        // if CX == 0 { goto ddoti_tail }
+
+ddoti_loop: // Loop unrolled 2x   do {
+	CVTSS2SD (SI), X2       // X_i = float64(x[ix])
+	CVTSS2SD (SI)(R8*1), X3
+	CVTSS2SD (DI), X4       // X_(i+2) = float64(y[iy])
+	CVTSS2SD (DI)(R9*1), X5
+	MULSD    X4, X2         // X_i *= X_(i+2)
+	MULSD    X5, X3
+	ADDSD    X2, X0         // X_j += X_i
+	ADDSD    X3, X1
+	LEAQ     (SI)(R8*2), SI // SI = &(SI[incX*2])
+	LEAQ     (DI)(R9*2), DI // DI = &(DI[incY*2])
+	LOOP     ddoti_loop     // } while --CX > 0
+	ADDSD    X1, X0         // Combine accumulators
+	CMPQ     BX, $0         // if BX == 0 { goto ddoti_end }
+	JE       ddoti_end
+
+ddoti_tail:
+	CVTSS2SD (SI), X2 // X2 = float64(x[ix])
+	CVTSS2SD (DI), X4 // X4 = float64(y[iy])
+	MULSD    X4, X2   // X2 *= X4
+	ADDSD    X2, X0   // sum += X2
+
+ddoti_end:
+	MOVSD X0, sum+88(FP)
+	RET
@@ -0,0 +1,63 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+#include "textflag.h"
+
+// func Dnrm2Inc(x []float32, n, incX, ix uintptr) (norm float64)
+TEXT ·Dnrm2Inc(SB), NOSPLIT, $0
+	MOVQ x_base+0(FP), SI    // SI = &x
+	MOVQ n+24(FP), CX        // CX = n
+	MOVQ ix+40(FP), R8       // R8 = ix
+	LEAQ (SI)(R8*4), SI      // SI = &(x[ix])
+	MOVQ incX+32(FP), R8     // R8 = incX
+	SHLQ $2, R8              // R8 *= sizeof(float32)
+	PXOR X0, X0              // Clear accumulators
+	PXOR X1, X1
+	PXOR X2, X2
+	PXOR X3, X3
+	CMPQ CX, $0              // if n == 0 { return 0 }
+	JE   dnrm2inc_end
+	LEAQ (R8)(R8*2), R9      // R9 = 3 * incX * sizeof(float32)
+	MOVQ CX, BX
+	ANDQ $3, BX              // BX = n % 4
+	SHRQ $2, CX              // CX = floor( n / 4 )
+	JZ   dnrm2inc_tail_start // if CX == 0 { goto dnrm2inc_tail_start }
+
+dnrm2inc_loop: // Loop unrolled 4x   do {
+	CVTSS2SD (SI), X4       // X_i = float64(x[ix])
+	CVTSS2SD (SI)(R8*1), X5
+	CVTSS2SD (SI)(R8*2), X6
+	CVTSS2SD (SI)(R9*1), X7
+	MULSD    X4, X4         // X_i *= X_i
+	MULSD    X5, X5
+	MULSD    X6, X6
+	MULSD    X7, X7
+	ADDSD    X4, X0         // X_j += X_i
+	ADDSD    X5, X1
+	ADDSD    X6, X2
+	ADDSD    X7, X3
+	LEAQ     (SI)(R8*4), SI // SI = &(SI[incX*4])
+	LOOP     dnrm2inc_loop  // } while --CX > 0
+	ADDSD    X1, X0         // Combine accumulators
+	ADDSD    X3, X2
+	ADDSD    X2, X0
+	CMPQ     BX, $0         // if BX == 0 { goto dnrm2inc_end }
+	JE       dnrm2inc_end
+
+dnrm2inc_tail_start:
+	MOVQ BX, CX
+
+dnrm2inc_tail: // do {
+	CVTSS2SD (SI), X4      // X4 = float64(x[ix])
+	MULSD    X4, X4        // X_i *= X_i
+	ADDSD    X4, X0        // sum += X4
+	ADDQ     R8, SI        // SI = &(SI[incX])
+	LOOP     dnrm2inc_tail // } while --CX > 0
+
+dnrm2inc_end:
+	SQRTSD X0, X0 // X0 = sqrt(X0)
+	MOVSD  X0, norm+48(FP)
+	RET
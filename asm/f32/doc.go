@@ -6,4 +6,20 @@
 // Development has moved to https://github.com/gonum/gonum.
 //
 // Package f32 provides float32 vector primitives.
+//
+// Float64ToFloat32 and Float64ToFloat32Inc round as the Go conversion
+// float32(v) does: to nearest, ties to even. Values too large for float32
+// become ±Inf, values too small become subnormal or signed zero, and NaN
+// stays NaN. Float32ToFloat64 and Float32ToFloat64Inc are exact.
+//
+// The reduction kernels may add their terms in any order. A result formed
+// from m terms has an error of at most γ(m) times the sum of the absolute
+// values of the terms, where γ(m) = m·u/(1-m·u) and u is the unit roundoff
+// of the result type: 2^-24 for DotUnitary, DotInc and the Sdsdot kernels,
+// and 2^-53 for the Ddot, Dsum, Dasum and Dnrm2 kernels, which accumulate
+// in float64. A dot product of n elements has n terms, or n+1 with the
+// alpha of Sdsdot, and the norm of n elements is bounded as a sum of n+1
+// terms.
 package f32
+
+//go:generate go run ../internal/asmgen -pkg f32
@@ -0,0 +1,91 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !amd64 noasm appengine
+
+package f32
+
+import "math"
+
+// DsumUnitary is
+//  for _, v := range x {
+//  	sum += float64(v)
+//  }
+//  return
+func DsumUnitary(x []float32) (sum float64) {
+	for _, v := range x {
+		sum += float64(v)
+	}
+	return
+}
+
+// DsumInc is
+//  for i := 0; i < int(n); i++ {
+//  	sum += float64(x[ix])
+//  	ix += incX
+//  }
+//  return
+func DsumInc(x []float32, n, incX, ix uintptr) (sum float64) {
+	for i := 0; i < int(n); i++ {
+		sum += float64(x[ix])
+		ix += incX
+	}
+	return
+}
+
+// DasumUnitary is
+//  for _, v := range x {
+//  	sum += math.Abs(float64(v))
+//  }
+//  return
+func DasumUnitary(x []float32) (sum float64) {
+	for _, v := range x {
+		sum += math.Abs(float64(v))
+	}
+	return
+}
+
+// DasumInc is
+//  for i := 0; i < int(n); i++ {
+//  	sum += math.Abs(float64(x[ix]))
+//  	ix += incX
+//  }
+//  return
+func DasumInc(x []float32, n, incX, ix uintptr) (sum float64) {
+	for i := 0; i < int(n); i++ {
+		sum += math.Abs(float64(x[ix]))
+		ix += incX
+	}
+	return
+}
+
+// Dnrm2Unitary is
+//  for _, v := range x {
+//  	sum += float64(v) * float64(v)
+//  }
+//  return math.Sqrt(sum)
+func Dnrm2Unitary(x []float32) (norm float64) {
+	var sum float64
+	for _, v := range x {
+		sum += float64(v) * float64(v)
+	}
+	return math.Sqrt(sum)
+}
+
+// Dnrm2Inc is
+//  for i := 0; i < int(n); i++ {
+//  	v := float64(x[ix])
+//  	sum += v * v
+//  	ix += incX
+//  }
+//  return math.Sqrt(sum)
+func Dnrm2Inc(x []float32, n, incX, ix uintptr) (norm float64) {
+	var sum float64
+	for i := 0; i < int(n); i++ {
+		v := float64(x[ix])
+		sum += v * v
+		ix += incX
+	}
+	return math.Sqrt(sum)
+}
@@ -0,0 +1,48 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+#include "textflag.h"
+
+// func Float32ToFloat64(dst []float64, x []float32)
+TEXT ·Float32ToFloat64(SB), NOSPLIT, $0
+	MOVQ    dst_base+0(FP), DI // DI = &dst
+	MOVQ    x_base+24(FP), SI  // SI = &x
+	MOVQ    x_len+32(FP), CX   // CX = min( len(x), len(dst) )
+	CMPQ    dst_len+8(FP), CX
+	CMOVQLE dst_len+8(FP), CX
+	CMPQ    CX, $0             // if CX == 0 { return }
+	JE      f32to64_end
+	XORQ    AX, AX             // i = 0
+	MOVQ    CX, BX
+	ANDQ    $7, BX             // BX = len % 8
+	SHRQ    $3, CX             // CX = floor( len / 8 )
+	JZ      f32to64_tail_start // if CX == 0 { goto f32to64_tail_start }
+
+f32to64_loop: // Loop unrolled 8x   do {
+	CVTPS2PD (SI)(AX*4), X0 // X_i = float64(x[i:i+2])
+	CVTPS2PD 8(SI)(AX*4), X1
+	CVTPS2PD 16(SI)(AX*4), X2
+	CVTPS2PD 24(SI)(AX*4), X3
+	MOVUPS   X0, (DI)(AX*8) // dst[i:i+2] = X_i
+	MOVUPS   X1, 16(DI)(AX*8)
+	MOVUPS   X2, 32(DI)(AX*8)
+	MOVUPS   X3, 48(DI)(AX*8)
+	ADDQ     $8, AX         // i += 8
+	LOOP     f32to64_loop   // } while --CX > 0
+	CMPQ     BX, $0         // if BX == 0 { return }
+	JE       f32to64_end
+
+f32to64_tail_start:
+	MOVQ BX, CX
+
+f32to64_tail: // do {
+	CVTSS2SD (SI)(AX*4), X0 // X0 = float64(x[i])
+	MOVSD    X0, (DI)(AX*8) // dst[i] = X0
+	INCQ     AX             // i++
+	LOOP     f32to64_tail   // } while --CX > 0
+
+f32to64_end:
+	RET
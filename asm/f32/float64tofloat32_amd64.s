@@ -0,0 +1,52 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+#include "textflag.h"
+
+// func Float64ToFloat32(dst []float32, x []float64)
+TEXT ·Float64ToFloat32(SB), NOSPLIT, $0
+	MOVQ    dst_base+0(FP), DI // DI = &dst
+	MOVQ    x_base+24(FP), SI  // SI = &x
+	MOVQ    x_len+32(FP), CX   // CX = min( len(x), len(dst) )
+	CMPQ    dst_len+8(FP), CX
+	CMOVQLE dst_len+8(FP), CX
+	CMPQ    CX, $0             // if CX == 0 { return }
+	JE      f64to32_end
+	XORQ    AX, AX             // i = 0
+	MOVQ    CX, BX
+	ANDQ    $7, BX             // BX = len % 8
+	SHRQ    $3, CX             // CX = floor( len / 8 )
+	JZ      f64to32_tail_start // if CX == 0 { goto f64to32_tail_start }
+
+f64to32_loop: // Loop unrolled 8x   do {
+	MOVUPS   (SI)(AX*8), X0 // X_i = x[i:i+2]
+	MOVUPS   16(SI)(AX*8), X1
+	MOVUPS   32(SI)(AX*8), X2
+	MOVUPS   48(SI)(AX*8), X3
+	CVTPD2PS X0, X0         // X_i = { 0, 0, float32(x[i+1]), float32(x[i]) }
+	CVTPD2PS X1, X1
+	CVTPD2PS X2, X2
+	CVTPD2PS X3, X3
+	MOVLHPS  X1, X0         // X0 = float32(x[i:i+4])
+	MOVLHPS  X3, X2         // X2 = float32(x[i+4:i+8])
+	MOVUPS   X0, (DI)(AX*4) // dst[i:i+8] = { X0, X2 }
+	MOVUPS   X2, 16(DI)(AX*4)
+	ADDQ     $8, AX         // i += 8
+	LOOP     f64to32_loop   // } while --CX > 0
+	CMPQ     BX, $0         // if BX == 0 { return }
+	JE       f64to32_end
+
+f64to32_tail_start:
+	MOVQ BX, CX
+
+f64to32_tail: // do {
+	CVTSD2SS (SI)(AX*8), X0 // X0 = float32(x[i])
+	MOVSS    X0, (DI)(AX*4) // dst[i] = X0
+	INCQ     AX             // i++
+	LOOP     f64to32_tail   // } while --CX > 0
+
+f64to32_end:
+	RET
@@ -0,0 +1,57 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+#include "textflag.h"
+
+// func Float64ToFloat32Inc(dst []float32, incDst, idst uintptr, x []float64, n, incX, ix uintptr)
+TEXT ·Float64ToFloat32Inc(SB), NOSPLIT, $0
+	MOVQ n+64(FP), CX        // CX = n
+	CMPQ CX, $0              // if n == 0 { return }
+	JE   f64to32i_end
+	MOVQ dst_base+0(FP), DI  // DI = &dst
+	MOVQ x_base+40(FP), SI   // SI = &x
+	MOVQ idst+32(FP), R8     // R8 = idst
+	MOVQ ix+80(FP), R9       // R9 = ix
+	LEAQ (DI)(R8*4), DI      // DI = &(dst[idst])
+	LEAQ (SI)(R9*8), SI      // SI = &(x[ix])
+	MOVQ incDst+24(FP), R8   // R8 = incDst
+	SHLQ $2, R8              // R8 *= sizeof(float32)
+	MOVQ incX+72(FP), R9     // R9 = incX
+	SHLQ $3, R9              // R9 *= sizeof(float64)
+	MOVQ CX, BX
+	ANDQ $3, BX              // BX = n % 4
+	SHRQ $2, CX              // CX = floor( n / 4 )
+	JZ   f64to32i_tail_start // if CX == 0 { goto f64to32i_tail_start }
+	LEAQ (R9)(R9*2), R10     // R10 = 3 * incX * sizeof(float64)
+	LEAQ (R8)(R8*2), R11     // R11 = 3 * incDst * sizeof(float32)
+
+f64to32i_loop: // Loop unrolled 4x   do {
+	CVTSD2SS (SI), X0       // X_i = float32(x[ix])
+	CVTSD2SS (SI)(R9*1), X1
+	CVTSD2SS (SI)(R9*2), X2
+	CVTSD2SS (SI)(R10*1), X3
+	MOVSS    X0, (DI)       // dst[idst] = X_i
+	MOVSS    X1, (DI)(R8*1)
+	MOVSS    X2, (DI)(R8*2)
+	MOVSS    X3, (DI)(R11*1)
+	LEAQ     (SI)(R9*4), SI // SI = &(SI[incX*4])
+	LEAQ     (DI)(R8*4), DI // DI = &(DI[incDst*4])
+	LOOP     f64to32i_loop  // } while --CX > 0
+	CMPQ     BX, $0         // if BX == 0 { return }
+	JE       f64to32i_end
+
+f64to32i_tail_start:
+	MOVQ BX, CX
+
+f64to32i_tail: // do {
+	CVTSD2SS (SI), X0      // X0 = float32(x[ix])
+	MOVSS    X0, (DI)      // dst[idst] = X0
+	ADDQ     R9, SI        // SI = &(SI[incX])
+	ADDQ     R8, DI        // DI = &(DI[incDst])
+	LOOP     f64to32i_tail // } while --CX > 0
+
+f64to32i_end:
+	RET
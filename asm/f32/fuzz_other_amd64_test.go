@@ -0,0 +1,330 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18 && !noasm && !appengine
+// +build go1.18,!noasm,!appengine
+
+package f32
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gonum/internal/asm/internal/asmtest"
+)
+
+// The fuzz targets in this file cover the assembly kernels that are not
+// generated by asmgen. They use the helpers in fuzz_amd64_test.go.
+
+func FuzzDdotUnitary(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, _, _, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		x := newFuzzVector[float32](s, int(n), 1, fuzzFront(off, 0))
+		y := newFuzzVector[float32](s, int(n), 1, fuzzFront(off, 1))
+		xr, yr := x.Clone(), y.Clone()
+
+		got := DdotUnitary(x.Slice(), y.Slice())
+
+		var (
+			want  float64
+			terms fuzzTerms
+		)
+		yv := yr.Slice()
+		for i, v := range xr.Slice() {
+			want += float64(yv[i]) * float64(v)
+			addFuzzTerms(&terms, float64(yv[i]), float64(v))
+		}
+		checkFuzzSum(t, "DdotUnitary", got, want, terms)
+		asmtest.CheckVector(t, "x", x, xr)
+		asmtest.CheckVector(t, "y", y, yr)
+	})
+}
+
+func FuzzDdotInc(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, incX, incY, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		ln, incx, incy := int(n), fuzzInc(incX), fuzzInc(incY)
+		x := newFuzzVector[float32](s, ln, incx, fuzzFront(off, 0))
+		y := newFuzzVector[float32](s, ln, incy, fuzzFront(off, 1))
+		xr, yr := x.Clone(), y.Clone()
+		ix, iy := fuzzStart(ln, incx), fuzzStart(ln, incy)
+
+		got := DdotInc(x.Slice(), y.Slice(), uintptr(ln), uintptr(incx), uintptr(incy), uintptr(ix), uintptr(iy))
+
+		var (
+			want  float64
+			terms fuzzTerms
+		)
+		xv, yv := xr.Slice(), yr.Slice()
+		for i := 0; i < ln; i++ {
+			want += float64(yv[iy]) * float64(xv[ix])
+			addFuzzTerms(&terms, float64(yv[iy]), float64(xv[ix]))
+			ix += incx
+			iy += incy
+		}
+		checkFuzzSum(t, "DdotInc", got, want, terms)
+		asmtest.CheckVector(t, "x", x, xr)
+		asmtest.CheckVector(t, "y", y, yr)
+	})
+}
+
+// fuzzReduce checks the result of kernel, called with a vector read from
+// data of n elements with increment inc, against the in order sum of term
+// applied to each element.
+func fuzzReduce(t *testing.T, name string, data []byte, n uint8, inc int, off uint8, kernel func(x []float32, n, incX, ix uintptr) float64, term func(float32) float64) {
+	t.Helper()
+	s := &fuzzSource{data: data}
+	ln := int(n)
+	x := newFuzzVector[float32](s, ln, inc, fuzzFront(off, 0))
+	xr := x.Clone()
+	ix := fuzzStart(ln, inc)
+
+	got := kernel(x.Slice(), uintptr(ln), uintptr(inc), uintptr(ix))
+
+	var (
+		want  float64
+		terms fuzzTerms
+	)
+	xv := xr.Slice()
+	for i := 0; i < ln; i++ {
+		v := term(xv[ix])
+		want += v
+		addFuzzTerms(&terms, v, 1)
+		ix += inc
+	}
+	checkFuzzSum(t, name, got, want, terms)
+	asmtest.CheckVector(t, "x", x, xr)
+}
+
+func FuzzDsumUnitary(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, _, _, _ int8, off uint8) {
+		fuzzReduce(t, "DsumUnitary", data, n, 1, off, func(x []float32, _, _, _ uintptr) float64 {
+			return DsumUnitary(x)
+		}, func(v float32) float64 {
+			return float64(v)
+		})
+	})
+}
+
+func FuzzDsumInc(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, incX, _, _ int8, off uint8) {
+		fuzzReduce(t, "DsumInc", data, n, fuzzInc(incX), off, DsumInc, func(v float32) float64 {
+			return float64(v)
+		})
+	})
+}
+
+func FuzzDasumUnitary(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, _, _, _ int8, off uint8) {
+		fuzzReduce(t, "DasumUnitary", data, n, 1, off, func(x []float32, _, _, _ uintptr) float64 {
+			return DasumUnitary(x)
+		}, func(v float32) float64 {
+			return math.Abs(float64(v))
+		})
+	})
+}
+
+func FuzzDasumInc(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, incX, _, _ int8, off uint8) {
+		fuzzReduce(t, "DasumInc", data, n, fuzzInc(incX), off, DasumInc, func(v float32) float64 {
+			return math.Abs(float64(v))
+		})
+	})
+}
+
+// checkFuzzNorm checks that the Euclidean norm got computed by an
+// assembly kernel matches want, computed in order by its reference.
+// The squares of float32 values are exact in float64 and their sum
+// cannot overflow, so the results may differ only by the rounding of
+// the additions and the square root.
+func checkFuzzNorm(t *testing.T, name string, got, want float64, n int) {
+	t.Helper()
+	var ok bool
+	switch {
+	case math.IsNaN(want):
+		ok = math.IsNaN(got)
+	case math.IsInf(want, 0):
+		ok = got == want
+	default:
+		ok = math.Abs(got-want) <= float64(n+2)*0x1p-53*want
+	}
+	if !ok {
+		t.Errorf("%s: unexpected result: got %v want %v", name, got, want)
+	}
+}
+
+func FuzzDnrm2Unitary(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, _, _, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		x := newFuzzVector[float32](s, int(n), 1, fuzzFront(off, 0))
+		xr := x.Clone()
+
+		got := Dnrm2Unitary(x.Slice())
+
+		var sum float64
+		for _, v := range xr.Slice() {
+			sum += float64(v) * float64(v)
+		}
+		checkFuzzNorm(t, "Dnrm2Unitary", got, math.Sqrt(sum), int(n))
+		asmtest.CheckVector(t, "x", x, xr)
+	})
+}
+
+func FuzzDnrm2Inc(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, incX, _, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		ln, incx := int(n), fuzzInc(incX)
+		x := newFuzzVector[float32](s, ln, incx, fuzzFront(off, 0))
+		xr := x.Clone()
+		ix := fuzzStart(ln, incx)
+
+		got := Dnrm2Inc(x.Slice(), uintptr(ln), uintptr(incx), uintptr(ix))
+
+		var sum float64
+		xv := xr.Slice()
+		for i := 0; i < ln; i++ {
+			v := float64(xv[ix])
+			sum += v * v
+			ix += incx
+		}
+		checkFuzzNorm(t, "Dnrm2Inc", got, math.Sqrt(sum), ln)
+		asmtest.CheckVector(t, "x", x, xr)
+	})
+}
+
+func FuzzDaxpyUnitary(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, _, _, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		alpha := fuzzValue[float64](s)
+		x := newFuzzVector[float32](s, int(n), 1, fuzzFront(off, 0))
+		y := newFuzzVector[float64](s, int(n), 1, fuzzFront(off, 1))
+		xr, yr := x.Clone(), y.Clone()
+
+		DaxpyUnitary(alpha, x.Slice(), y.Slice())
+
+		yv := yr.Slice()
+		for i, v := range xr.Slice() {
+			yv[i] += alpha * float64(v)
+		}
+		asmtest.CheckVector(t, "x", x, xr)
+		asmtest.CheckVector(t, "y", y, yr)
+	})
+}
+
+func FuzzDaxpyInc(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, incX, incY, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		alpha := fuzzValue[float64](s)
+		ln, incx, incy := int(n), fuzzInc(incX), fuzzInc(incY)
+		x := newFuzzVector[float32](s, ln, incx, fuzzFront(off, 0))
+		y := newFuzzVector[float64](s, ln, incy, fuzzFront(off, 1))
+		xr, yr := x.Clone(), y.Clone()
+		ix, iy := fuzzStart(ln, incx), fuzzStart(ln, incy)
+
+		DaxpyInc(alpha, x.Slice(), y.Slice(), uintptr(ln), uintptr(incx), uintptr(incy), uintptr(ix), uintptr(iy))
+
+		xv, yv := xr.Slice(), yr.Slice()
+		for i := 0; i < ln; i++ {
+			yv[iy] += alpha * float64(xv[ix])
+			ix += incx
+			iy += incy
+		}
+		asmtest.CheckVector(t, "x", x, xr)
+		asmtest.CheckVector(t, "y", y, yr)
+	})
+}
+
+func FuzzFloat64ToFloat32(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, _, _, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		x := newFuzzVector[float64](s, int(n), 1, fuzzFront(off, 0))
+		dst := newFuzzVector[float32](s, int(n), 1, fuzzFront(off, 1))
+		xr, dstr := x.Clone(), dst.Clone()
+
+		Float64ToFloat32(dst.Slice(), x.Slice())
+
+		dv := dstr.Slice()
+		for i, v := range xr.Slice() {
+			dv[i] = float32(v)
+		}
+		asmtest.CheckVector(t, "x", x, xr)
+		asmtest.CheckVector(t, "dst", dst, dstr)
+	})
+}
+
+func FuzzFloat64ToFloat32Inc(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, incX, _, incDst int8, off uint8) {
+		s := &fuzzSource{data: data}
+		ln, incx, incd := int(n), fuzzInc(incX), fuzzInc(incDst)
+		x := newFuzzVector[float64](s, ln, incx, fuzzFront(off, 0))
+		dst := newFuzzVector[float32](s, ln, incd, fuzzFront(off, 1))
+		xr, dstr := x.Clone(), dst.Clone()
+		ix, idst := fuzzStart(ln, incx), fuzzStart(ln, incd)
+
+		Float64ToFloat32Inc(dst.Slice(), uintptr(incd), uintptr(idst), x.Slice(), uintptr(ln), uintptr(incx), uintptr(ix))
+
+		xv, dv := xr.Slice(), dstr.Slice()
+		for i := 0; i < ln; i++ {
+			dv[idst] = float32(xv[ix])
+			ix += incx
+			idst += incd
+		}
+		asmtest.CheckVector(t, "x", x, xr)
+		asmtest.CheckVector(t, "dst", dst, dstr)
+	})
+}
+
+func FuzzFloat32ToFloat64(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, _, _, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		x := newFuzzVector[float32](s, int(n), 1, fuzzFront(off, 0))
+		dst := newFuzzVector[float64](s, int(n), 1, fuzzFront(off, 1))
+		xr, dstr := x.Clone(), dst.Clone()
+
+		Float32ToFloat64(dst.Slice(), x.Slice())
+
+		dv := dstr.Slice()
+		for i, v := range xr.Slice() {
+			dv[i] = float64(v)
+		}
+		asmtest.CheckVector(t, "x", x, xr)
+		asmtest.CheckVector(t, "dst", dst, dstr)
+	})
+}
+
+func FuzzFloat32ToFloat64Inc(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, incX, _, incDst int8, off uint8) {
+		s := &fuzzSource{data: data}
+		ln, incx, incd := int(n), fuzzInc(incX), fuzzInc(incDst)
+		x := newFuzzVector[float32](s, ln, incx, fuzzFront(off, 0))
+		dst := newFuzzVector[float64](s, ln, incd, fuzzFront(off, 1))
+		xr, dstr := x.Clone(), dst.Clone()
+		ix, idst := fuzzStart(ln, incx), fuzzStart(ln, incd)
+
+		Float32ToFloat64Inc(dst.Slice(), uintptr(incd), uintptr(idst), x.Slice(), uintptr(ln), uintptr(incx), uintptr(ix))
+
+		xv, dv := xr.Slice(), dstr.Slice()
+		for i := 0; i < ln; i++ {
+			dv[idst] = float64(xv[ix])
+			ix += incx
+			idst += incd
+		}
+		asmtest.CheckVector(t, "x", x, xr)
+		asmtest.CheckVector(t, "dst", dst, dstr)
+	})
+}
@@ -0,0 +1,124 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18
+// +build go1.18
+
+package f32
+
+import "github.com/gonum/internal/asm/internal/asmtest"
+
+// kernelSink64 receives the float64 results of the benchmarked reductions.
+var kernelSink64 float64
+
+// otherBenchKernels are the benchmarks of the kernels that are not
+// generated by asmgen.
+var otherBenchKernels = []benchKernel{
+	{name: "DdotUnitary", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 2, Bytes: 2 * 4}, setup: func(n, _ int) func() {
+		x := asmtest.BenchVector[float32](n, 1)
+		y := asmtest.BenchVector[float32](n, 1)
+		return func() {
+			kernelSink64 = DdotUnitary(x, y)
+		}
+	}},
+	{name: "DdotInc", incs: asmtest.BenchIncs, cost: asmtest.Cost{Flops: 2, Bytes: 2 * 4}, setup: func(n, inc int) func() {
+		x := asmtest.BenchVector[float32](n, inc)
+		y := asmtest.BenchVector[float32](n, -inc)
+		return func() {
+			kernelSink64 = DdotInc(x, y, uintptr(n), uintptr(inc), uintptr(-inc), uintptr(asmtest.Start(n, inc)), uintptr(asmtest.Start(n, -inc)))
+		}
+	}},
+	{name: "SdsdotUnitary", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 2, Bytes: 2 * 4}, setup: func(n, _ int) func() {
+		x := asmtest.BenchVector[float32](n, 1)
+		y := asmtest.BenchVector[float32](n, 1)
+		return func() {
+			kernelSink = SdsdotUnitary(1, x, y)
+		}
+	}},
+	{name: "SdsdotInc", incs: asmtest.BenchIncs, cost: asmtest.Cost{Flops: 2, Bytes: 2 * 4}, setup: func(n, inc int) func() {
+		x := asmtest.BenchVector[float32](n, inc)
+		y := asmtest.BenchVector[float32](n, -inc)
+		return func() {
+			kernelSink = SdsdotInc(1, x, y, uintptr(n), uintptr(inc), uintptr(-inc), uintptr(asmtest.Start(n, inc)), uintptr(asmtest.Start(n, -inc)))
+		}
+	}},
+	{name: "DsumUnitary", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 1, Bytes: 4}, setup: func(n, _ int) func() {
+		x := asmtest.BenchVector[float32](n, 1)
+		return func() {
+			kernelSink64 = DsumUnitary(x)
+		}
+	}},
+	{name: "DsumInc", incs: asmtest.BenchIncs, cost: asmtest.Cost{Flops: 1, Bytes: 4}, setup: func(n, inc int) func() {
+		x := asmtest.BenchVector[float32](n, inc)
+		return func() {
+			kernelSink64 = DsumInc(x, uintptr(n), uintptr(inc), uintptr(asmtest.Start(n, inc)))
+		}
+	}},
+	{name: "DasumUnitary", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 1, Bytes: 4}, setup: func(n, _ int) func() {
+		x := asmtest.BenchVector[float32](n, 1)
+		return func() {
+			kernelSink64 = DasumUnitary(x)
+		}
+	}},
+	{name: "DasumInc", incs: asmtest.BenchIncs, cost: asmtest.Cost{Flops: 1, Bytes: 4}, setup: func(n, inc int) func() {
+		x := asmtest.BenchVector[float32](n, inc)
+		return func() {
+			kernelSink64 = DasumInc(x, uintptr(n), uintptr(inc), uintptr(asmtest.Start(n, inc)))
+		}
+	}},
+	{name: "Dnrm2Unitary", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 2, Bytes: 4}, setup: func(n, _ int) func() {
+		x := asmtest.BenchVector[float32](n, 1)
+		return func() {
+			kernelSink64 = Dnrm2Unitary(x)
+		}
+	}},
+	{name: "Dnrm2Inc", incs: asmtest.BenchIncs, cost: asmtest.Cost{Flops: 2, Bytes: 4}, setup: func(n, inc int) func() {
+		x := asmtest.BenchVector[float32](n, inc)
+		return func() {
+			kernelSink64 = Dnrm2Inc(x, uintptr(n), uintptr(inc), uintptr(asmtest.Start(n, inc)))
+		}
+	}},
+	{name: "DaxpyUnitary", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 2, Bytes: 4 + 2*8}, setup: func(n, _ int) func() {
+		x := asmtest.BenchVector[float32](n, 1)
+		y := asmtest.BenchVector[float64](n, 1)
+		return func() {
+			DaxpyUnitary(1, x, y)
+		}
+	}},
+	{name: "DaxpyInc", incs: asmtest.BenchIncs, cost: asmtest.Cost{Flops: 2, Bytes: 4 + 2*8}, setup: func(n, inc int) func() {
+		x := asmtest.BenchVector[float32](n, inc)
+		y := asmtest.BenchVector[float64](n, -inc)
+		return func() {
+			DaxpyInc(1, x, y, uintptr(n), uintptr(inc), uintptr(-inc), uintptr(asmtest.Start(n, inc)), uintptr(asmtest.Start(n, -inc)))
+		}
+	}},
+	{name: "Float64ToFloat32", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Bytes: 4 + 8}, setup: func(n, _ int) func() {
+		x := asmtest.BenchVector[float64](n, 1)
+		dst := asmtest.BenchVector[float32](n, 1)
+		return func() {
+			Float64ToFloat32(dst, x)
+		}
+	}},
+	{name: "Float64ToFloat32Inc", incs: asmtest.BenchIncs, cost: asmtest.Cost{Bytes: 4 + 8}, setup: func(n, inc int) func() {
+		x := asmtest.BenchVector[float64](n, inc)
+		dst := asmtest.BenchVector[float32](n, -inc)
+		return func() {
+			Float64ToFloat32Inc(dst, uintptr(-inc), uintptr(asmtest.Start(n, -inc)), x, uintptr(n), uintptr(inc), uintptr(asmtest.Start(n, inc)))
+		}
+	}},
+	{name: "Float32ToFloat64", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Bytes: 4 + 8}, setup: func(n, _ int) func() {
+		x := asmtest.BenchVector[float32](n, 1)
+		dst := asmtest.BenchVector[float64](n, 1)
+		return func() {
+			Float32ToFloat64(dst, x)
+		}
+	}},
+	{name: "Float32ToFloat64Inc", incs: asmtest.BenchIncs, cost: asmtest.Cost{Bytes: 4 + 8}, setup: func(n, inc int) func() {
+		x := asmtest.BenchVector[float32](n, inc)
+		dst := asmtest.BenchVector[float64](n, -inc)
+		return func() {
+			Float32ToFloat64Inc(dst, uintptr(-inc), uintptr(asmtest.Start(n, -inc)), x, uintptr(n), uintptr(inc), uintptr(asmtest.Start(n, inc)))
+		}
+	}},
+}
@@ -0,0 +1,190 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package f32
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+var mixedLens = []int{0, 1, 2, 3, 4, 5, 7, 8, 9, 15, 16, 17, 31, 32, 33, 100, 1001}
+
+func randVec(rnd *rand.Rand, n int) []float32 {
+	v := make([]float32, n)
+	for i := range v {
+		v[i] = float32(rnd.NormFloat64())
+	}
+	return v
+}
+
+// near reports whether got agrees with want to within a relative tolerance
+// of tol measured against scale, the magnitude of the summed terms.
+func near(got, want, scale, tol float64) bool {
+	if math.IsNaN(want) {
+		return math.IsNaN(got)
+	}
+	return math.Abs(got-want) <= tol*scale
+}
+
+func TestDdot(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range mixedLens {
+		for _, inc := range []uintptr{1, 2, 3} {
+			x, y := randVec(rnd, n), randVec(rnd, n)
+			var want, scale float64
+			for i := range x {
+				p := float64(x[i]) * float64(y[i])
+				want += p
+				scale += math.Abs(p)
+			}
+			prefix := fmt.Sprintf("n=%d inc=%d", n, inc)
+
+			gx, gy := guardVector(x, nan, 3), guardVector(y, nan, 3)
+			if got := DdotUnitary(gx[3:3+n], gy[3:3+n]); !near(got, want, scale, 1e-14) {
+				t.Errorf("%s: unexpected DdotUnitary result: got %v want %v", prefix, got, want)
+			}
+
+			gx, gy = guardIncVector(x, nan, inc, 3), guardIncVector(y, nan, inc+1, 3)
+			got := DdotInc(gx[3:len(gx)-3], gy[3:len(gy)-3], uintptr(n), inc, inc+1, 0, 0)
+			if !near(got, want, scale, 1e-14) {
+				t.Errorf("%s: unexpected DdotInc result: got %v want %v", prefix, got, want)
+			}
+		}
+	}
+}
+
+func TestSdsdot(t *testing.T) {
+	// The float32 sum of x[i]*y[i] loses the unit contribution,
+	// whereas accumulating in float64 retains it.
+	x := []float32{1 << 25, 1, -(1 << 25), 1}
+	y := []float32{1, 1, 1, 1}
+	if got := SdsdotUnitary(0.5, x, y); got != 2.5 {
+		t.Errorf("unexpected SdsdotUnitary result: got %v want 2.5", got)
+	}
+	gx, gy := guardIncVector(x, nan, 2, 1), guardIncVector(y, nan, 3, 1)
+	if got := SdsdotInc(0.5, gx[1:len(gx)-1], gy[1:len(gy)-1], 4, 2, 3, 0, 0); got != 2.5 {
+		t.Errorf("unexpected SdsdotInc result: got %v want 2.5", got)
+	}
+}
+
+func TestDsumDasumDnrm2(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range mixedLens {
+		for _, inc := range []int{1, 2, 5, -1, -3} {
+			x := randVec(rnd, n)
+			var sum, asum, sumsq float64
+			for _, v := range x {
+				sum += float64(v)
+				asum += math.Abs(float64(v))
+				sumsq += float64(v) * float64(v)
+			}
+			nrm2 := math.Sqrt(sumsq)
+			prefix := fmt.Sprintf("n=%d inc=%d", n, inc)
+
+			g := guardVector(x, nan, 3)
+			xu := g[3 : 3+n]
+			if got := DsumUnitary(xu); !near(got, sum, asum, 1e-14) {
+				t.Errorf("%s: unexpected DsumUnitary result: got %v want %v", prefix, got, sum)
+			}
+			if got := DasumUnitary(xu); !near(got, asum, asum, 1e-14) {
+				t.Errorf("%s: unexpected DasumUnitary result: got %v want %v", prefix, got, asum)
+			}
+			if got := Dnrm2Unitary(xu); !near(got, nrm2, nrm2, 1e-14) {
+				t.Errorf("%s: unexpected Dnrm2Unitary result: got %v want %v", prefix, got, nrm2)
+			}
+
+			absInc, ix := inc, 0
+			if inc < 0 {
+				absInc = -inc
+				if n > 0 {
+					ix = (n - 1) * absInc
+				}
+			}
+			g = guardIncVector(x, nan, uintptr(absInc), 3)
+			xi := g[3 : len(g)-3]
+			if got := DsumInc(xi, uintptr(n), uintptr(inc), uintptr(ix)); !near(got, sum, asum, 1e-14) {
+				t.Errorf("%s: unexpected DsumInc result: got %v want %v", prefix, got, sum)
+			}
+			if got := DasumInc(xi, uintptr(n), uintptr(inc), uintptr(ix)); !near(got, asum, asum, 1e-14) {
+				t.Errorf("%s: unexpected DasumInc result: got %v want %v", prefix, got, asum)
+			}
+			if got := Dnrm2Inc(xi, uintptr(n), uintptr(inc), uintptr(ix)); !near(got, nrm2, nrm2, 1e-14) {
+				t.Errorf("%s: unexpected Dnrm2Inc result: got %v want %v", prefix, got, nrm2)
+			}
+		}
+	}
+
+	// Squares of large float32 values overflow in float32 but not in float64.
+	big := []float32{math.MaxFloat32, math.MaxFloat32, -math.MaxFloat32}
+	want := math.Sqrt(3) * math.MaxFloat32
+	if got := Dnrm2Unitary(big); !near(got, want, want, 1e-15) {
+		t.Errorf("unexpected Dnrm2Unitary result for large input: got %v want %v", got, want)
+	}
+	if got := Dnrm2Inc(big, 3, 1, 0); !near(got, want, want, 1e-15) {
+		t.Errorf("unexpected Dnrm2Inc result for large input: got %v want %v", got, want)
+	}
+	if got := DasumUnitary([]float32{1, nan, 1}); !math.IsNaN(got) {
+		t.Errorf("unexpected DasumUnitary result for NaN input: got %v want NaN", got)
+	}
+}
+
+func TestDaxpy(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	const ygd = -1e300
+	for _, n := range mixedLens {
+		for _, inc := range []struct{ x, y uintptr }{{1, 1}, {2, 3}, {3, 1}} {
+			alpha := rnd.NormFloat64()
+			x := randVec(rnd, n)
+			y := make([]float64, n)
+			for i := range y {
+				y[i] = rnd.NormFloat64()
+			}
+			want := make([]float64, n)
+			for i := range want {
+				want[i] = y[i] + alpha*float64(x[i])
+			}
+			prefix := fmt.Sprintf("n=%d incX=%d incY=%d", n, inc.x, inc.y)
+
+			gx := guardVector(x, nan, 3)
+			gy := make([]float64, n+6)
+			for i := range gy {
+				gy[i] = ygd
+			}
+			copy(gy[3:], y)
+			DaxpyUnitary(alpha, gx[3:3+n], gy[3:3+n])
+			for i, w := range want {
+				if gy[3+i] != w {
+					t.Errorf("%s: unexpected DaxpyUnitary result at %d: got %v want %v", prefix, i, gy[3+i], w)
+				}
+			}
+			for _, i := range []int{0, 1, 2, n + 3, n + 4, n + 5} {
+				if gy[i] != ygd {
+					t.Errorf("%s: DaxpyUnitary guard violated at %d", prefix, i)
+				}
+			}
+
+			gx = guardIncVector(x, nan, inc.x, 3)
+			gy = make([]float64, n*int(inc.y)+6)
+			for i := range gy {
+				gy[i] = ygd
+			}
+			for i, v := range y {
+				gy[3+i*int(inc.y)] = v
+			}
+			DaxpyInc(alpha, gx[3:len(gx)-3], gy[3:len(gy)-3], uintptr(n), inc.x, inc.y, 0, 0)
+			for i := range gy {
+				w := float64(ygd)
+				if j := i - 3; j >= 0 && j%int(inc.y) == 0 && j/int(inc.y) < n {
+					w = want[j/int(inc.y)]
+				}
+				if gy[i] != w {
+					t.Errorf("%s: unexpected DaxpyInc value at %d: got %v want %v", prefix, i, gy[i], w)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18 && linux
+// +build go1.18,linux
+
+package f32
+
+import "github.com/gonum/internal/asm/internal/asmtest"
+
+// otherPageTests are the page tests for the kernels that are not
+// generated by asmgen.
+var otherPageTests = []pageTest{
+	{name: "DdotUnitary", incs: asmtest.PageUnitaryIncs, kernel: func(p *asmtest.Pages, n, _ int) {
+		x := asmtest.PageVector[float32](p, n, 1)
+		y := asmtest.PageVector[float32](p, n, 1)
+		DdotUnitary(x, y)
+	}},
+	{name: "DdotInc", incs: asmtest.PageIncs, kernel: func(p *asmtest.Pages, n, inc int) {
+		x := asmtest.PageVector[float32](p, n, inc)
+		y := asmtest.PageVector[float32](p, n, -inc)
+		DdotInc(x, y, uintptr(n), uintptr(inc), uintptr(-inc), uintptr(asmtest.Start(n, inc)), uintptr(asmtest.Start(n, -inc)))
+	}},
+	{name: "SdsdotUnitary", incs: asmtest.PageUnitaryIncs, kernel: func(p *asmtest.Pages, n, _ int) {
+		x := asmtest.PageVector[float32](p, n, 1)
+		y := asmtest.PageVector[float32](p, n, 1)
+		SdsdotUnitary(1, x, y)
+	}},
+	{name: "SdsdotInc", incs: asmtest.PageIncs, kernel: func(p *asmtest.Pages, n, inc int) {
+		x := asmtest.PageVector[float32](p, n, inc)
+		y := asmtest.PageVector[float32](p, n, -inc)
+		SdsdotInc(1, x, y, uintptr(n), uintptr(inc), uintptr(-inc), uintptr(asmtest.Start(n, inc)), uintptr(asmtest.Start(n, -inc)))
+	}},
+	{name: "DsumUnitary", incs: asmtest.PageUnitaryIncs, kernel: func(p *asmtest.Pages, n, _ int) {
+		x := asmtest.PageVector[float32](p, n, 1)
+		DsumUnitary(x)
+	}},
+	{name: "DsumInc", incs: asmtest.PageIncs, kernel: func(p *asmtest.Pages, n, inc int) {
+		x := asmtest.PageVector[float32](p, n, inc)
+		DsumInc(x, uintptr(n), uintptr(inc), uintptr(asmtest.Start(n, inc)))
+	}},
+	{name: "DasumUnitary", incs: asmtest.PageUnitaryIncs, kernel: func(p *asmtest.Pages, n, _ int) {
+		x := asmtest.PageVector[float32](p, n, 1)
+		DasumUnitary(x)
+	}},
+	{name: "DasumInc", incs: asmtest.PageIncs, kernel: func(p *asmtest.Pages, n, inc int) {
+		x := asmtest.PageVector[float32](p, n, inc)
+		DasumInc(x, uintptr(n), uintptr(inc), uintptr(asmtest.Start(n, inc)))
+	}},
+	{name: "Dnrm2Unitary", incs: asmtest.PageUnitaryIncs, kernel: func(p *asmtest.Pages, n, _ int) {
+		x := asmtest.PageVector[float32](p, n, 1)
+		Dnrm2Unitary(x)
+	}},
+	{name: "Dnrm2Inc", incs: asmtest.PageIncs, kernel: func(p *asmtest.Pages, n, inc int) {
+		x := asmtest.PageVector[float32](p, n, inc)
+		Dnrm2Inc(x, uintptr(n), uintptr(inc), uintptr(asmtest.Start(n, inc)))
+	}},
+	{name: "DaxpyUnitary", incs: asmtest.PageUnitaryIncs, kernel: func(p *asmtest.Pages, n, _ int) {
+		x := asmtest.PageVector[float32](p, n, 1)
+		y := asmtest.PageVector[float64](p, n, 1)
+		DaxpyUnitary(1, x, y)
+	}},
+	{name: "DaxpyInc", incs: asmtest.PageIncs, kernel: func(p *asmtest.Pages, n, inc int) {
+		x := asmtest.PageVector[float32](p, n, inc)
+		y := asmtest.PageVector[float64](p, n, -inc)
+		DaxpyInc(1, x, y, uintptr(n), uintptr(inc), uintptr(-inc), uintptr(asmtest.Start(n, inc)), uintptr(asmtest.Start(n, -inc)))
+	}},
+	{name: "Float64ToFloat32", incs: asmtest.PageUnitaryIncs, kernel: func(p *asmtest.Pages, n, _ int) {
+		x := asmtest.PageVector[float64](p, n, 1)
+		dst := asmtest.PageVector[float32](p, n, 1)
+		Float64ToFloat32(dst, x)
+	}},
+	{name: "Float64ToFloat32Inc", incs: asmtest.PageIncs, kernel: func(p *asmtest.Pages, n, inc int) {
+		x := asmtest.PageVector[float64](p, n, inc)
+		dst := asmtest.PageVector[float32](p, n, -inc)
+		Float64ToFloat32Inc(dst, uintptr(-inc), uintptr(asmtest.Start(n, -inc)), x, uintptr(n), uintptr(inc), uintptr(asmtest.Start(n, inc)))
+	}},
+	{name: "Float32ToFloat64", incs: asmtest.PageUnitaryIncs, kernel: func(p *asmtest.Pages, n, _ int) {
+		x := asmtest.PageVector[float32](p, n, 1)
+		dst := asmtest.PageVector[float64](p, n, 1)
+		Float32ToFloat64(dst, x)
+	}},
+	{name: "Float32ToFloat64Inc", incs: asmtest.PageIncs, kernel: func(p *asmtest.Pages, n, inc int) {
+		x := asmtest.PageVector[float32](p, n, inc)
+		dst := asmtest.PageVector[float64](p, n, -inc)
+		Float32ToFloat64Inc(dst, uintptr(-inc), uintptr(asmtest.Start(n, -inc)), x, uintptr(n), uintptr(inc), uintptr(asmtest.Start(n, inc)))
+	}},
+}
@@ -0,0 +1,19 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package f32
+
+// SdsdotUnitary is
+//  return float32(float64(alpha) + DdotUnitary(x, y))
+// The products and the running sum are formed in float64 and
+// rounded to float32 only once, as in the reference BLAS sdsdot.
+func SdsdotUnitary(alpha float32, x, y []float32) float32 {
+	return float32(float64(alpha) + DdotUnitary(x, y))
+}
+
+// SdsdotInc is
+//  return float32(float64(alpha) + DdotInc(x, y, n, incX, incY, ix, iy))
+func SdsdotInc(alpha float32, x, y []float32, n, incX, incY, ix, iy uintptr) float32 {
+	return float32(float64(alpha) + DdotInc(x, y, n, incX, incY, ix, iy))
+}
@@ -34,3 +34,107 @@ func AxpyInc(alpha float32, x, y []float32, n, incX, incY, ix, iy uintptr)
 //  	idst += incDst
 //  }
 func AxpyIncTo(dst []float32, incDst, idst uintptr, alpha float32, x, y []float32, n, incX, incY, ix, iy uintptr)
+
+// DdotUnitary is
+//  for i, v := range x {
+//  	sum += float64(y[i]) * float64(v)
+//  }
+//  return
+func DdotUnitary(x, y []float32) (sum float64)
+
+// DdotInc is
+//  for i := 0; i < int(n); i++ {
+//  	sum += float64(y[iy]) * float64(x[ix])
+//  	ix += incX
+//  	iy += incY
+//  }
+//  return
+func DdotInc(x, y []float32, n, incX, incY, ix, iy uintptr) (sum float64)
+
+// DsumUnitary is
+//  for _, v := range x {
+//  	sum += float64(v)
+//  }
+//  return
+func DsumUnitary(x []float32) (sum float64)
+
+// DsumInc is
+//  for i := 0; i < int(n); i++ {
+//  	sum += float64(x[ix])
+//  	ix += incX
+//  }
+//  return
+func DsumInc(x []float32, n, incX, ix uintptr) (sum float64)
+
+// DasumUnitary is
+//  for _, v := range x {
+//  	sum += math.Abs(float64(v))
+//  }
+//  return
+func DasumUnitary(x []float32) (sum float64)
+
+// DasumInc is
+//  for i := 0; i < int(n); i++ {
+//  	sum += math.Abs(float64(x[ix]))
+//  	ix += incX
+//  }
+//  return
+func DasumInc(x []float32, n, incX, ix uintptr) (sum float64)
+
+// Dnrm2Unitary is
+//  for _, v := range x {
+//  	sum += float64(v) * float64(v)
+//  }
+//  return math.Sqrt(sum)
+func Dnrm2Unitary(x []float32) (norm float64)
+
+// Dnrm2Inc is
+//  for i := 0; i < int(n); i++ {
+//  	v := float64(x[ix])
+//  	sum += v * v
+//  	ix += incX
+//  }
+//  return math.Sqrt(sum)
+func Dnrm2Inc(x []float32, n, incX, ix uintptr) (norm float64)
+
+// DaxpyUnitary is
+//  for i, v := range x {
+//  	y[i] += alpha * float64(v)
+//  }
+func DaxpyUnitary(alpha float64, x []float32, y []float64)
+
+// DaxpyInc is
+//  for i := 0; i < int(n); i++ {
+//  	y[iy] += alpha * float64(x[ix])
+//  	ix += incX
+//  	iy += incY
+//  }
+func DaxpyInc(alpha float64, x []float32, y []float64, n, incX, incY, ix, iy uintptr)
+
+// Float64ToFloat32 is
+//  for i, v := range x {
+//  	dst[i] = float32(v)
+//  }
+func Float64ToFloat32(dst []float32, x []float64)
+
+// Float64ToFloat32Inc is
+//  for i := 0; i < int(n); i++ {
+//  	dst[idst] = float32(x[ix])
+//  	ix += incX
+//  	idst += incDst
+//  }
+func Float64ToFloat32Inc(dst []float32, incDst, idst uintptr, x []float64, n, incX, ix uintptr)
+
+// Float32ToFloat64 is
+//  for i, v := range x {
+//  	dst[i] = float64(v)
+//  }
+func Float32ToFloat64(dst []float64, x []float32)
+
+// Float32ToFloat64Inc is
+//  for i := 0; i < int(n); i++ {
+//  	dst[idst] = float64(x[ix])
+//  	ix += incX
+//  	idst += incDst
+//  }
+func Float32ToFloat64Inc(dst []float64, incDst, idst uintptr, x []float32, n, incX, ix uintptr)
@@ -0,0 +1,130 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18
+// +build go1.18
+
+package f64
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+
+	"github.com/gonum/internal/asm/internal/asmtest"
+)
+
+// otherAccuracyTests are the accuracy tests for the kernels that are not
+// generated by asmgen. LinfDist rounds only the differences it compares,
+// so its result is the correctly rounded exact maximum.
+var otherAccuracyTests = []accuracyTest{
+	{name: "L1Norm", incs: asmtest.AccuracyUnitaryIncs, acc: asmtest.Accuracy{Cond: 1}, kernel: func(rnd *rand.Rand, n, _ int, acc asmtest.Accuracy) error {
+		x := asmtest.AccuracyVector[float64](rnd, n, 1)
+		return asmtest.CompareExact(L1Norm(x), asmtest.ExactSum(abs(x)), acc)
+	}},
+	{name: "L1NormInc", incs: asmtest.AccuracyPosIncs, acc: asmtest.Accuracy{Cond: 1}, kernel: func(rnd *rand.Rand, n, inc int, acc asmtest.Accuracy) error {
+		x := asmtest.AccuracyVector[float64](rnd, n, inc)
+		return asmtest.CompareExact(L1NormInc(x, n, inc), asmtest.ExactSum(abs(asmtest.Elems(x, n, inc))), acc)
+	}},
+	{name: "CumSum", incs: asmtest.AccuracyUnitaryIncs, acc: asmtest.Accuracy{Cond: 1}, kernel: func(rnd *rand.Rand, n, _ int, acc asmtest.Accuracy) error {
+		x := asmtest.AccuracyVector[float64](rnd, n, 1)
+		dst := make([]float64, n)
+		CumSum(dst, x)
+		for i, v := range dst {
+			if err := asmtest.CompareExact(v, asmtest.ExactSum(x[:i+1]), acc); err != nil {
+				return fmt.Errorf("element %d: %v", i, err)
+			}
+		}
+		return nil
+	}},
+	{name: "CumProd", incs: asmtest.AccuracyUnitaryIncs, acc: asmtest.Accuracy{Cond: 1}, kernel: func(rnd *rand.Rand, n, _ int, acc asmtest.Accuracy) error {
+		// The factors are scaled into [0.5, 1) so that no product of
+		// up to 1000 of them underflows.
+		x := asmtest.AccuracyVector[float64](rnd, n, 1)
+		for i, v := range x {
+			x[i], _ = math.Frexp(v)
+		}
+		dst := make([]float64, n)
+		CumProd(dst, x)
+		for i, v := range dst {
+			if err := asmtest.CompareExact(v, asmtest.ExactProd(x[:i+1]), acc); err != nil {
+				return fmt.Errorf("element %d: %v", i, err)
+			}
+		}
+		return nil
+	}},
+	{name: "L1Dist", incs: asmtest.AccuracyUnitaryIncs, acc: asmtest.Accuracy{Cond: 1}, kernel: func(rnd *rand.Rand, n, _ int, acc asmtest.Accuracy) error {
+		s := asmtest.AccuracyVector[float64](rnd, n, 1)
+		t := asmtest.AccuracyVector[float64](rnd, n, 1)
+		e := asmtest.NewExact()
+		for i, v := range s {
+			e.AddTerm(absDiff(v, t[i]), nil)
+		}
+		e.Terms = n
+		return asmtest.CompareExact(L1Dist(s, t), e, acc)
+	}},
+	{name: "LinfDist", incs: asmtest.AccuracyUnitaryIncs, acc: asmtest.Accuracy{ULPs: 0}, kernel: func(rnd *rand.Rand, n, _ int, acc asmtest.Accuracy) error {
+		s := asmtest.AccuracyVector[float64](rnd, n, 1)
+		t := asmtest.AccuracyVector[float64](rnd, n, 1)
+		e := asmtest.NewExact()
+		for i, v := range s {
+			if d := absDiff(v, t[i]); d.Cmp(e.Re) > 0 {
+				e.Re = d
+			}
+		}
+		return asmtest.CompareExact(LinfDist(s, t), e, acc)
+	}},
+	{name: "ReproDotUnitary", incs: asmtest.AccuracyUnitaryIncs, acc: asmtest.Accuracy{Cond: 1}, kernel: func(rnd *rand.Rand, n, _ int, acc asmtest.Accuracy) error {
+		x := asmtest.AccuracyVector[float64](rnd, n, 1)
+		y := asmtest.AccuracyVector[float64](rnd, n, 1)
+		return asmtest.CompareExact(ReproDotUnitary(x, y), asmtest.ExactDot(x, y), acc)
+	}},
+	{name: "ReproDotInc", incs: asmtest.AccuracyIncs, acc: asmtest.Accuracy{Cond: 1}, kernel: func(rnd *rand.Rand, n, inc int, acc asmtest.Accuracy) error {
+		x := asmtest.AccuracyVector[float64](rnd, n, inc)
+		y := asmtest.AccuracyVector[float64](rnd, n, -inc)
+		got := ReproDotInc(x, y, uintptr(n), uintptr(inc), uintptr(-inc), uintptr(asmtest.Start(n, inc)), uintptr(asmtest.Start(n, -inc)))
+		return asmtest.CompareExact(got, asmtest.ExactDot(asmtest.Elems(x, n, inc), asmtest.Elems(y, n, -inc)), acc)
+	}},
+	{name: "ReproL1Norm", incs: asmtest.AccuracyUnitaryIncs, acc: asmtest.Accuracy{Cond: 1}, kernel: func(rnd *rand.Rand, n, _ int, acc asmtest.Accuracy) error {
+		x := asmtest.AccuracyVector[float64](rnd, n, 1)
+		return asmtest.CompareExact(ReproL1Norm(x), asmtest.ExactSum(abs(x)), acc)
+	}},
+	{name: "ReproL1NormInc", incs: asmtest.AccuracyPosIncs, acc: asmtest.Accuracy{Cond: 1}, kernel: func(rnd *rand.Rand, n, inc int, acc asmtest.Accuracy) error {
+		x := asmtest.AccuracyVector[float64](rnd, n, inc)
+		return asmtest.CompareExact(ReproL1NormInc(x, n, inc), asmtest.ExactSum(abs(asmtest.Elems(x, n, inc))), acc)
+	}},
+	{name: "ReproCumSum", incs: asmtest.AccuracyUnitaryIncs, acc: asmtest.Accuracy{Cond: 1}, kernel: func(rnd *rand.Rand, n, _ int, acc asmtest.Accuracy) error {
+		// The error of each element is bounded relative to the sum
+		// of the absolute values of all of x.
+		x := asmtest.AccuracyVector[float64](rnd, n, 1)
+		dst := make([]float64, n)
+		ReproCumSum(dst, x)
+		all := asmtest.ExactSum(abs(x))
+		for i, v := range dst {
+			e := asmtest.ExactSum(x[:i+1])
+			e.AbsRe, e.Terms = all.AbsRe, n
+			if err := asmtest.CompareExact(v, e, acc); err != nil {
+				return fmt.Errorf("element %d: %v", i, err)
+			}
+		}
+		return nil
+	}},
+}
+
+// absDiff returns |a-b| exactly.
+func absDiff(a, b float64) *big.Float {
+	d, _ := asmtest.Big(a)
+	e, _ := asmtest.Big(b)
+	return d.Sub(d, e).Abs(d)
+}
+
+// abs returns the absolute values of the elements of x.
+func abs(x []float64) []float64 {
+	a := make([]float64, len(x))
+	for i, v := range x {
+		a[i] = math.Abs(v)
+	}
+	return a
+}
@@ -11,24 +11,6 @@ import (
 	"testing"
 )
 
-const (
-	testLen = 1e5
-)
-
-var (
-	a = 2.0
-	x = make([]float64, testLen)
-	y = make([]float64, testLen)
-	z = make([]float64, testLen)
-)
-
-func init() {
-	for n := range x {
-		x[n] = float64(n)
-		y[n] = float64(n)
-	}
-}
-
 func BenchmarkAxpyUnitary(t *testing.B) {
 	naiveaxpyu := func(a float64, x, y []float64) {
 		for i, v := range x {
@@ -6,4 +6,20 @@
 // Development has moved to https://github.com/gonum/gonum.
 //
 // Package f64 provides float64 vector primitives.
+//
+// The reduction kernels may add or multiply their terms in any order. A
+// result formed from m terms has an error of at most γ(m) times the sum of
+// the absolute values of the terms, where γ(m) = m·u/(1-m·u) and u = 2^-53.
+// This holds for DotUnitary, DotInc, L1Norm, L1NormInc, L1Dist and each
+// element of CumSum; element i of CumProd has a relative error of at most
+// γ(i+1). LinfDist is correctly rounded.
+//
+// Those results depend on the order in which a kernel adds its terms,
+// which differs between architectures and build tags. ReproDotUnitary,
+// ReproDotInc, ReproL1Norm, ReproL1NormInc and ReproCumSum compute the
+// same reductions within the same bounds with results that are bitwise
+// identical on every architecture and under every build tag, at the cost
+// of two passes over their input.
 package f64
+
+//go:generate go run ../internal/asmgen -pkg f64
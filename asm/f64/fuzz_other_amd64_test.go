@@ -0,0 +1,291 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18 && !noasm && !appengine
+// +build go1.18,!noasm,!appengine
+
+package f64
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gonum/internal/asm/internal/asmtest"
+)
+
+// The fuzz targets in this file cover the assembly kernels that are not
+// generated by asmgen. They use the helpers in fuzz_amd64_test.go.
+
+func FuzzL1Norm(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, _, _, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		x := newFuzzVector[float64](s, int(n), 1, fuzzFront(off, 0))
+		xr := x.Clone()
+
+		got := L1Norm(x.Slice())
+
+		var (
+			want  float64
+			terms fuzzTerms
+		)
+		for _, v := range xr.Slice() {
+			want += math.Abs(v)
+			addFuzzTerms(&terms, math.Abs(v), 1)
+		}
+		checkFuzzSum(t, "L1Norm", got, want, terms)
+		asmtest.CheckVector(t, "x", x, xr)
+	})
+}
+
+func FuzzL1NormInc(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, incX, _, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		ln, incx := int(n), fuzzPosInc(incX)
+		x := newFuzzVector[float64](s, ln, incx, fuzzFront(off, 0))
+		xr := x.Clone()
+
+		got := L1NormInc(x.Slice(), ln, incx)
+
+		var (
+			want  float64
+			terms fuzzTerms
+		)
+		xv := xr.Slice()
+		for i := 0; i < ln*incx; i += incx {
+			want += math.Abs(xv[i])
+			addFuzzTerms(&terms, math.Abs(xv[i]), 1)
+		}
+		checkFuzzSum(t, "L1NormInc", got, want, terms)
+		asmtest.CheckVector(t, "x", x, xr)
+	})
+}
+
+func FuzzAddConst(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, _, _, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		alpha := fuzzValue[float64](s)
+		x := newFuzzVector[float64](s, int(n), 1, fuzzFront(off, 0))
+		xr := x.Clone()
+
+		AddConst(alpha, x.Slice())
+
+		xv := xr.Slice()
+		for i := range xv {
+			xv[i] += alpha
+		}
+		asmtest.CheckVector(t, "x", x, xr)
+	})
+}
+
+func FuzzAdd(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, _, _, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		dst := newFuzzVector[float64](s, int(n), 1, fuzzFront(off, 0))
+		x := newFuzzVector[float64](s, int(n), 1, fuzzFront(off, 1))
+		dstr, xr := dst.Clone(), x.Clone()
+
+		Add(dst.Slice(), x.Slice())
+
+		dv := dstr.Slice()
+		for i, v := range xr.Slice() {
+			dv[i] += v
+		}
+		asmtest.CheckVector(t, "dst", dst, dstr)
+		asmtest.CheckVector(t, "s", x, xr)
+	})
+}
+
+func FuzzCumSum(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, _, _, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		x := newFuzzVector[float64](s, int(n), 1, fuzzFront(off, 0))
+		dst := newFuzzVector[float64](s, int(n), 1, fuzzFront(off, 1))
+		xr, dstr := x.Clone(), dst.Clone()
+
+		CumSum(dst.Slice(), x.Slice())
+
+		// The kernel adds pairs of elements before adding them to the
+		// running sum, so each element of dst is checked as a sum.
+		xv, dv, got := xr.Slice(), dstr.Slice(), dst.Slice()
+		for i, v := range xv {
+			if i == 0 {
+				dv[0] = v
+			} else {
+				dv[i] = dv[i-1] + v
+			}
+			checkFuzzSum(t, "CumSum", got[i], dv[i], fuzzTerms{re: xv[:i+1]})
+			if t.Failed() {
+				return
+			}
+		}
+		copy(dv, got)
+		asmtest.CheckVector(t, "x", x, xr)
+		asmtest.CheckVector(t, "dst", dst, dstr)
+	})
+}
+
+func FuzzCumProd(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, _, _, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		x := newFuzzVector[float64](s, int(n), 1, fuzzFront(off, 0))
+		dst := newFuzzVector[float64](s, int(n), 1, fuzzFront(off, 1))
+		xr, dstr := x.Clone(), dst.Clone()
+
+		CumProd(dst.Slice(), x.Slice())
+
+		// The kernel multiplies pairs of elements before multiplying
+		// them into the running product, so the elements of dst may
+		// differ from the reference by rounding. They are compared
+		// while every product of consecutive elements is normal in any
+		// grouping, which holds while the spread of the binary exponents
+		// of the partial products, bounded by lo and hi, is small enough.
+		// A NaN element makes all later products NaN in any grouping.
+		var (
+			lo, hi, e int
+			exact     = true
+			nan       bool
+		)
+		xv, dv, got := xr.Slice(), dstr.Slice(), dst.Slice()
+		for i, v := range xv {
+			if i == 0 {
+				dv[0] = v
+			} else {
+				dv[i] = dv[i-1] * v
+			}
+			nan = nan || math.IsNaN(v)
+			if v == 0 || math.IsInf(v, 0) || math.IsNaN(v) {
+				exact = false
+			} else {
+				_, exp := math.Frexp(v)
+				e += exp
+				if e < lo {
+					lo = e
+				}
+				if e > hi {
+					hi = e
+				}
+				exact = exact && hi-lo+i+1 < 1021
+			}
+			var ok bool
+			switch {
+			case nan:
+				ok = math.IsNaN(got[i])
+			case exact:
+				ok = math.Abs(got[i]-dv[i]) <= 2*float64(i+2)*0x1p-53*math.Abs(dv[i])
+			default:
+				ok = true
+			}
+			if !ok {
+				t.Errorf("CumProd: unexpected value at element %d: got %v want %v", i, got[i], dv[i])
+				return
+			}
+		}
+		copy(dv, got)
+		asmtest.CheckVector(t, "x", x, xr)
+		asmtest.CheckVector(t, "dst", dst, dstr)
+	})
+}
+
+func FuzzDiv(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, _, _, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		dst := newFuzzVector[float64](s, int(n), 1, fuzzFront(off, 0))
+		x := newFuzzVector[float64](s, int(n), 1, fuzzFront(off, 1))
+		dstr, xr := dst.Clone(), x.Clone()
+
+		Div(dst.Slice(), x.Slice())
+
+		dv := dstr.Slice()
+		for i, v := range xr.Slice() {
+			dv[i] /= v
+		}
+		asmtest.CheckVector(t, "dst", dst, dstr)
+		asmtest.CheckVector(t, "s", x, xr)
+	})
+}
+
+func FuzzDivTo(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, _, _, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		x := newFuzzVector[float64](s, int(n), 1, fuzzFront(off, 0))
+		y := newFuzzVector[float64](s, int(n), 1, fuzzFront(off, 1))
+		dst := newFuzzVector[float64](s, int(n), 1, fuzzFront(off, 2))
+		xr, yr, dstr := x.Clone(), y.Clone(), dst.Clone()
+
+		DivTo(dst.Slice(), x.Slice(), y.Slice())
+
+		yv, dv := yr.Slice(), dstr.Slice()
+		for i, v := range xr.Slice() {
+			dv[i] = v / yv[i]
+		}
+		asmtest.CheckVector(t, "x", x, xr)
+		asmtest.CheckVector(t, "y", y, yr)
+		asmtest.CheckVector(t, "dst", dst, dstr)
+	})
+}
+
+func FuzzL1Dist(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, _, _, _ int8, off uint8) {
+		src := &fuzzSource{data: data}
+		s := newFuzzVector[float64](src, int(n), 1, fuzzFront(off, 0))
+		tv := newFuzzVector[float64](src, int(n), 1, fuzzFront(off, 1))
+		sr, tr := s.Clone(), tv.Clone()
+
+		got := L1Dist(s.Slice(), tv.Slice())
+
+		var (
+			want  float64
+			terms fuzzTerms
+		)
+		w := tr.Slice()
+		for i, v := range sr.Slice() {
+			d := math.Abs(w[i] - v)
+			want += d
+			addFuzzTerms(&terms, d, 1)
+		}
+		checkFuzzSum(t, "L1Dist", got, want, terms)
+		asmtest.CheckVector(t, "s", s, sr)
+		asmtest.CheckVector(t, "t", tv, tr)
+	})
+}
+
+func FuzzLinfDist(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, _, _, _ int8, off uint8) {
+		src := &fuzzSource{data: data}
+		s := newFuzzVector[float64](src, int(n), 1, fuzzFront(off, 0))
+		tv := newFuzzVector[float64](src, int(n), 1, fuzzFront(off, 1))
+		sr, tr := s.Clone(), tv.Clone()
+
+		got := LinfDist(s.Slice(), tv.Slice())
+
+		// The kernel uses MAXPD, which returns its second operand when
+		// either is NaN, so a NaN difference propagates differently from
+		// the reference. The result is only checked when there is none.
+		var (
+			want float64
+			nan  bool
+		)
+		w := tr.Slice()
+		for i, v := range sr.Slice() {
+			d := math.Abs(w[i] - v)
+			nan = nan || math.IsNaN(d)
+			want = math.Max(want, d)
+		}
+		if !nan && math.Float64bits(got) != math.Float64bits(want) {
+			t.Errorf("LinfDist: unexpected result: got %v want %v", got, want)
+		}
+		asmtest.CheckVector(t, "s", s, sr)
+		asmtest.CheckVector(t, "t", tv, tr)
+	})
+}
@@ -0,0 +1,117 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18
+// +build go1.18
+
+package f64
+
+import "github.com/gonum/internal/asm/internal/asmtest"
+
+// otherBenchKernels are the benchmarks of the kernels that are not
+// generated by asmgen. The cost of a Repro kernel is that of the plain
+// reduction it computes.
+var otherBenchKernels = []benchKernel{
+	{name: "L1Norm", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 1, Bytes: 8}, setup: func(n, _ int) func() {
+		x := asmtest.BenchVector[float64](n, 1)
+		return func() {
+			kernelSink = L1Norm(x)
+		}
+	}},
+	{name: "L1NormInc", incs: asmtest.BenchPosIncs, cost: asmtest.Cost{Flops: 1, Bytes: 8}, setup: func(n, inc int) func() {
+		x := asmtest.BenchVector[float64](n, inc)
+		return func() {
+			kernelSink = L1NormInc(x, n, inc)
+		}
+	}},
+	{name: "AddConst", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 1, Bytes: 2 * 8}, setup: func(n, _ int) func() {
+		x := asmtest.BenchVector[float64](n, 1)
+		return func() {
+			AddConst(1, x)
+		}
+	}},
+	{name: "Add", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 1, Bytes: 3 * 8}, setup: func(n, _ int) func() {
+		dst := asmtest.BenchVector[float64](n, 1)
+		s := asmtest.BenchVector[float64](n, 1)
+		return func() {
+			Add(dst, s)
+		}
+	}},
+	{name: "Div", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 1, Bytes: 3 * 8}, setup: func(n, _ int) func() {
+		dst := asmtest.BenchVector[float64](n, 1)
+		s := asmtest.BenchVector[float64](n, 1)
+		return func() {
+			Div(dst, s)
+		}
+	}},
+	{name: "DivTo", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 1, Bytes: 3 * 8}, setup: func(n, _ int) func() {
+		x := asmtest.BenchVector[float64](n, 1)
+		y := asmtest.BenchVector[float64](n, 1)
+		dst := asmtest.BenchVector[float64](n, 1)
+		return func() {
+			DivTo(dst, x, y)
+		}
+	}},
+	{name: "CumSum", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 1, Bytes: 2 * 8}, setup: func(n, _ int) func() {
+		dst := asmtest.BenchVector[float64](n, 1)
+		s := asmtest.BenchVector[float64](n, 1)
+		return func() {
+			CumSum(dst, s)
+		}
+	}},
+	{name: "CumProd", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 1, Bytes: 2 * 8}, setup: func(n, _ int) func() {
+		dst := asmtest.BenchVector[float64](n, 1)
+		s := asmtest.BenchVector[float64](n, 1)
+		return func() {
+			CumProd(dst, s)
+		}
+	}},
+	{name: "L1Dist", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 2, Bytes: 2 * 8}, setup: func(n, _ int) func() {
+		s := asmtest.BenchVector[float64](n, 1)
+		t := asmtest.BenchVector[float64](n, 1)
+		return func() {
+			kernelSink = L1Dist(s, t)
+		}
+	}},
+	{name: "LinfDist", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 2, Bytes: 2 * 8}, setup: func(n, _ int) func() {
+		s := asmtest.BenchVector[float64](n, 1)
+		t := asmtest.BenchVector[float64](n, 1)
+		return func() {
+			kernelSink = LinfDist(s, t)
+		}
+	}},
+	{name: "ReproDotUnitary", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 2, Bytes: 2 * 8}, setup: func(n, _ int) func() {
+		x := asmtest.BenchVector[float64](n, 1)
+		y := asmtest.BenchVector[float64](n, 1)
+		return func() {
+			kernelSink = ReproDotUnitary(x, y)
+		}
+	}},
+	{name: "ReproDotInc", incs: asmtest.BenchIncs, cost: asmtest.Cost{Flops: 2, Bytes: 2 * 8}, setup: func(n, inc int) func() {
+		x := asmtest.BenchVector[float64](n, inc)
+		y := asmtest.BenchVector[float64](n, -inc)
+		return func() {
+			kernelSink = ReproDotInc(x, y, uintptr(n), uintptr(inc), uintptr(-inc), uintptr(asmtest.Start(n, inc)), uintptr(asmtest.Start(n, -inc)))
+		}
+	}},
+	{name: "ReproL1Norm", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 1, Bytes: 8}, setup: func(n, _ int) func() {
+		x := asmtest.BenchVector[float64](n, 1)
+		return func() {
+			kernelSink = ReproL1Norm(x)
+		}
+	}},
+	{name: "ReproL1NormInc", incs: asmtest.BenchPosIncs, cost: asmtest.Cost{Flops: 1, Bytes: 8}, setup: func(n, inc int) func() {
+		x := asmtest.BenchVector[float64](n, inc)
+		return func() {
+			kernelSink = ReproL1NormInc(x, n, inc)
+		}
+	}},
+	{name: "ReproCumSum", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 1, Bytes: 2 * 8}, setup: func(n, _ int) func() {
+		dst := asmtest.BenchVector[float64](n, 1)
+		s := asmtest.BenchVector[float64](n, 1)
+		return func() {
+			ReproCumSum(dst, s)
+		}
+	}},
+}
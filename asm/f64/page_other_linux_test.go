@@ -0,0 +1,63 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18 && linux
+// +build go1.18,linux
+
+package f64
+
+import "github.com/gonum/internal/asm/internal/asmtest"
+
+// otherPageTests are the page tests for the kernels that are not
+// generated by asmgen.
+var otherPageTests = []pageTest{
+	{name: "L1Norm", incs: asmtest.PageUnitaryIncs, kernel: func(p *asmtest.Pages, n, _ int) {
+		x := asmtest.PageVector[float64](p, n, 1)
+		L1Norm(x)
+	}},
+	{name: "L1NormInc", incs: asmtest.PagePosIncs, kernel: func(p *asmtest.Pages, n, inc int) {
+		x := asmtest.PageVector[float64](p, n, inc)
+		L1NormInc(x, n, inc)
+	}},
+	{name: "AddConst", incs: asmtest.PageUnitaryIncs, kernel: func(p *asmtest.Pages, n, _ int) {
+		x := asmtest.PageVector[float64](p, n, 1)
+		AddConst(1, x)
+	}},
+	{name: "Add", incs: asmtest.PageUnitaryIncs, kernel: func(p *asmtest.Pages, n, _ int) {
+		dst := asmtest.PageVector[float64](p, n, 1)
+		s := asmtest.PageVector[float64](p, n, 1)
+		Add(dst, s)
+	}},
+	{name: "Div", incs: asmtest.PageUnitaryIncs, kernel: func(p *asmtest.Pages, n, _ int) {
+		dst := asmtest.PageVector[float64](p, n, 1)
+		s := asmtest.PageVector[float64](p, n, 1)
+		Div(dst, s)
+	}},
+	{name: "CumSum", incs: asmtest.PageUnitaryIncs, kernel: func(p *asmtest.Pages, n, _ int) {
+		dst := asmtest.PageVector[float64](p, n, 1)
+		s := asmtest.PageVector[float64](p, n, 1)
+		CumSum(dst, s)
+	}},
+	{name: "CumProd", incs: asmtest.PageUnitaryIncs, kernel: func(p *asmtest.Pages, n, _ int) {
+		dst := asmtest.PageVector[float64](p, n, 1)
+		s := asmtest.PageVector[float64](p, n, 1)
+		CumProd(dst, s)
+	}},
+	{name: "DivTo", incs: asmtest.PageUnitaryIncs, kernel: func(p *asmtest.Pages, n, _ int) {
+		x := asmtest.PageVector[float64](p, n, 1)
+		y := asmtest.PageVector[float64](p, n, 1)
+		dst := asmtest.PageVector[float64](p, n, 1)
+		DivTo(dst, x, y)
+	}},
+	{name: "L1Dist", incs: asmtest.PageUnitaryIncs, kernel: func(p *asmtest.Pages, n, _ int) {
+		s := asmtest.PageVector[float64](p, n, 1)
+		t := asmtest.PageVector[float64](p, n, 1)
+		L1Dist(s, t)
+	}},
+	{name: "LinfDist", incs: asmtest.PageUnitaryIncs, kernel: func(p *asmtest.Pages, n, _ int) {
+		s := asmtest.PageVector[float64](p, n, 1)
+		t := asmtest.PageVector[float64](p, n, 1)
+		LinfDist(s, t)
+	}},
+}
@@ -0,0 +1,212 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package f64
+
+import (
+	"math"
+	"math/bits"
+)
+
+// The Repro kernels are reductions whose results are bitwise identical on
+// every architecture and under every build tag, whatever the order of the
+// terms and the alignment of the vectors. They use the pre-rounded binned
+// summation of Demmel and Nguyen that ReproBLAS is built on: each term is
+// split into parts that are multiples of a fixed unit for each of a few
+// folds, the parts of each fold are summed exactly, and the fold sums are
+// added in a fixed order. The units depend only on the number of terms
+// and the largest finite term, neither of which depends on the order.
+//
+// The kernels are written in Go so that every build performs the same
+// operations. Products are converted explicitly to float64 so that the
+// compiler does not fuse them with the following addition.
+
+// reproFolds is the number of folds of a binned sum. For n terms each
+// fold holds 53-⌈log2(n+2)⌉ bits of the sum, so the folds hold enough
+// bits for the error of the result to be bounded by γ(n) times the sum
+// of the magnitudes of the terms only for n ≤ 2^26. The error bounds of
+// the Repro kernels are stated for such n; for longer vectors the results
+// remain reproducible but may be less accurate.
+const reproFolds = 3
+
+// The terms of a binned sum are scaled by a power of two if needed to put
+// the extractor of its first fold between 2^reproMinExp and 2^reproMaxExp,
+// so that no extraction overflows and the last fold remains normal.
+const (
+	reproMaxExp = 1000
+	reproMinExp = -860
+)
+
+// binned is an exact binned sum of terms of bounded magnitude.
+type binned struct {
+	// scale is the binary exponent the finite terms are scaled by.
+	scale int
+	// sigma holds the extractor of each fold, and sum holds the exact
+	// sum of the parts of the terms extracted by it.
+	sigma [reproFolds]float64
+	sum   [reproFolds]float64
+	// nan, posInf and negInf record the non-finite terms, which are
+	// not added to sum.
+	nan, posInf, negInf bool
+}
+
+// newBinned returns an empty binned sum of n terms whose finite values
+// are at most max in magnitude.
+func newBinned(n int, max float64) *binned {
+	// The extractor of the first fold is 2^(m+e) with 2^m ≥ n+2 and
+	// max < 2^e. The parts it extracts are multiples of 2^(m+e-53)
+	// of magnitude at most 2^e, so any n of them, and the residuals
+	// left for the next fold, are summed exactly in any order. See
+	// Rump, Ogita and Oishi, Accurate floating-point summation part I,
+	// SIAM J. Sci. Comput. 31(1), 2008.
+	m := bits.Len(uint(n + 1))
+	_, e := math.Frexp(max)
+	b := &binned{}
+	top := m + e
+	switch {
+	case top > reproMaxExp:
+		b.scale = reproMaxExp - top
+	case top < reproMinExp:
+		b.scale = reproMinExp - top
+	}
+	sigma := math.Ldexp(1, top+b.scale)
+	for k := range b.sigma {
+		b.sigma[k] = sigma
+		sigma = math.Ldexp(sigma, m-53)
+	}
+	return b
+}
+
+// add adds v to b. The finite part of |v| must be at most the maximum b
+// was created with.
+func (b *binned) add(v float64) {
+	switch {
+	case math.IsNaN(v):
+		b.nan = true
+		return
+	case math.IsInf(v, 1):
+		b.posInf = true
+		return
+	case math.IsInf(v, -1):
+		b.negInf = true
+		return
+	}
+	if b.scale != 0 {
+		v = math.Ldexp(v, b.scale)
+	}
+	for k, s := range b.sigma {
+		q := (s + v) - s
+		b.sum[k] += q
+		v -= q
+	}
+}
+
+// result returns the sum of the terms added to b, rounded from the fold
+// sums in a fixed order. The residual left by the last fold is dropped.
+func (b *binned) result() float64 {
+	switch {
+	case b.nan || b.posInf && b.negInf:
+		return math.NaN()
+	case b.posInf:
+		return math.Inf(1)
+	case b.negInf:
+		return math.Inf(-1)
+	}
+	s := b.sum[reproFolds-1]
+	for k := reproFolds - 2; k >= 0; k-- {
+		s = b.sum[k] + s
+	}
+	return math.Ldexp(s, -b.scale)
+}
+
+// absMax returns the larger of max and |v|, ignoring v if it is not
+// finite.
+func absMax(max, v float64) float64 {
+	v = math.Abs(v)
+	if v > max && !math.IsInf(v, 0) {
+		return v
+	}
+	return max
+}
+
+// ReproDotUnitary returns the dot product of x and y, as DotUnitary does,
+// reproducibly. Each product is rounded once, and the result is within
+// γ(n)·Σ|x[i]·y[i]| of the exact dot product for n = len(x) ≤ 2^26.
+func ReproDotUnitary(x, y []float64) float64 {
+	var max float64
+	for i, v := range x {
+		max = absMax(max, float64(y[i]*v))
+	}
+	b := newBinned(len(x), max)
+	for i, v := range x {
+		b.add(float64(y[i] * v))
+	}
+	return b.result()
+}
+
+// ReproDotInc returns the dot product of the n elements of x and y with
+// increments incX and incY starting at ix and iy, as DotInc does,
+// reproducibly. Its error is bounded as that of ReproDotUnitary.
+func ReproDotInc(x, y []float64, n, incX, incY, ix, iy uintptr) float64 {
+	var max float64
+	for i, jx, jy := 0, ix, iy; i < int(n); i, jx, jy = i+1, jx+incX, jy+incY {
+		max = absMax(max, float64(y[jy]*x[jx]))
+	}
+	b := newBinned(int(n), max)
+	for i := 0; i < int(n); i++ {
+		b.add(float64(y[iy] * x[ix]))
+		ix += incX
+		iy += incY
+	}
+	return b.result()
+}
+
+// ReproL1Norm returns the sum of the absolute values of the elements of
+// x, as L1Norm does, reproducibly. The result is within γ(n)·Σ|x[i]| of
+// the exact sum for n = len(x) ≤ 2^26.
+func ReproL1Norm(x []float64) float64 {
+	var max float64
+	for _, v := range x {
+		max = absMax(max, v)
+	}
+	b := newBinned(len(x), max)
+	for _, v := range x {
+		b.add(math.Abs(v))
+	}
+	return b.result()
+}
+
+// ReproL1NormInc returns the sum of the absolute values of the n elements
+// of x with increment incX, as L1NormInc does, reproducibly. Its error is
+// bounded as that of ReproL1Norm.
+func ReproL1NormInc(x []float64, n, incX int) float64 {
+	var max float64
+	for i := 0; i < n*incX; i += incX {
+		max = absMax(max, x[i])
+	}
+	b := newBinned(n, max)
+	for i := 0; i < n*incX; i += incX {
+		b.add(math.Abs(x[i]))
+	}
+	return b.result()
+}
+
+// ReproCumSum stores the cumulative sums of s in dst and returns dst, as
+// CumSum does, reproducibly. Element i of dst is within γ(n)·Σ|s[j]| of
+// the exact sum of s[:i+1], where n = len(s) ≤ 2^26 and the sum of the
+// absolute values is over all of s. Since the binning of the sums
+// depends on all of s, dst[i] may differ in its last bits from the last
+// cumulative sum of s[:i+1].
+func ReproCumSum(dst, s []float64) []float64 {
+	var max float64
+	for _, v := range s {
+		max = absMax(max, v)
+	}
+	b := newBinned(len(s), max)
+	for i, v := range s {
+		b.add(v)
+		dst[i] = b.result()
+	}
+	return dst
+}
@@ -0,0 +1,180 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package f64
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// reproVec returns n random values whose binary exponents span [-40, 40]
+// and whose signs are mixed, so that plain sums of them depend on the
+// order of the terms.
+func reproVec(n int, rnd *rand.Rand) []float64 {
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = math.Ldexp(rnd.NormFloat64(), rnd.Intn(81)-40)
+	}
+	return x
+}
+
+// identical returns whether a and b have the same representation.
+func identical(a, b float64) bool {
+	return math.Float64bits(a) == math.Float64bits(b)
+}
+
+func TestReproOrder(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 4, 7, 8, 15, 16, 17, 100, 1000} {
+		x, y := reproVec(n, rnd), reproVec(n, rnd)
+		dot, norm := ReproDotUnitary(x, y), ReproL1Norm(x)
+		sum := ReproCumSum(make([]float64, n), x)[n-1]
+
+		// Strided vectors hold the elements at every third position
+		// of x and in reverse order at every second position of y.
+		xs, ys := make([]float64, 3*n), make([]float64, 2*n)
+		for i := range xs {
+			xs[i] = math.NaN()
+		}
+		for i := range ys {
+			ys[i] = math.NaN()
+		}
+		for i := 0; i < n; i++ {
+			xs[3*i] = x[i]
+			ys[2*(n-1-i)] = y[i]
+		}
+		dec := -2
+		if got := ReproDotInc(xs, ys, uintptr(n), 3, uintptr(dec), 0, uintptr(2*(n-1))); !identical(got, dot) {
+			t.Errorf("n=%d: unexpected ReproDotInc result: got %v want %v", n, got, dot)
+		}
+		if got := ReproL1NormInc(xs, n, 3); !identical(got, norm) {
+			t.Errorf("n=%d: unexpected ReproL1NormInc result: got %v want %v", n, got, norm)
+		}
+
+		for trial := 0; trial < 10; trial++ {
+			px, py := make([]float64, n), make([]float64, n)
+			for i, j := range rnd.Perm(n) {
+				px[i], py[i] = x[j], y[j]
+			}
+			if got := ReproDotUnitary(px, py); !identical(got, dot) {
+				t.Errorf("n=%d: ReproDotUnitary depends on order: got %v want %v", n, got, dot)
+			}
+			if got := ReproL1Norm(px); !identical(got, norm) {
+				t.Errorf("n=%d: ReproL1Norm depends on order: got %v want %v", n, got, norm)
+			}
+			if got := ReproCumSum(make([]float64, n), px)[n-1]; !identical(got, sum) {
+				t.Errorf("n=%d: ReproCumSum depends on order: got %v want %v", n, got, sum)
+			}
+		}
+	}
+}
+
+// gamma returns γ(n) = n·u/(1-n·u) for the unit roundoff u of float64.
+func gamma(n int) float64 {
+	nu := float64(n) * 0x1p-53
+	return nu / (1 - nu)
+}
+
+// exactSum returns the sum of x rounded once to float64, and the sum of
+// the absolute values of x.
+func exactSum(x []float64) (sum, abs float64) {
+	s := new(big.Float).SetPrec(512)
+	for _, v := range x {
+		s.Add(s, new(big.Float).SetFloat64(v))
+		abs += math.Abs(v)
+	}
+	sum, _ = s.Float64()
+	return sum, abs
+}
+
+func TestReproAccuracy(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 10, 100, 1000, 1 << 16} {
+		x, y := reproVec(n, rnd), reproVec(n, rnd)
+		xy := make([]float64, n)
+		for i := range x {
+			xy[i] = float64(y[i] * x[i])
+		}
+		want, abs := exactSum(xy)
+		if got := ReproDotUnitary(x, y); math.Abs(got-want) > gamma(n)*abs {
+			t.Errorf("n=%d: ReproDotUnitary error too large: got %v want %v±%v", n, got, want, gamma(n)*abs)
+		}
+		want, abs = exactSum(x)
+		if got := ReproCumSum(make([]float64, n), x)[n-1]; math.Abs(got-want) > gamma(n)*abs {
+			t.Errorf("n=%d: ReproCumSum error too large: got %v want %v±%v", n, got, want, gamma(n)*abs)
+		}
+	}
+
+	// The error bound is documented for up to 2^26 terms. The binned
+	// sum of that many terms, all but a few of which are zero and so
+	// do not change the sum, must hold the terms within the bound.
+	const n = 1 << 26
+	x := reproVec(1000, rnd)
+	var max float64
+	for _, v := range x {
+		max = absMax(max, v)
+	}
+	b := newBinned(n, max)
+	for _, v := range x {
+		b.add(v)
+	}
+	want, abs := exactSum(x)
+	if got := b.result(); math.Abs(got-want) > gamma(n)*abs {
+		t.Errorf("n=2^26: binned sum error too large: got %v want %v±%v", got, want, gamma(n)*abs)
+	}
+}
+
+func TestReproSpecial(t *testing.T) {
+	inf, nan := math.Inf(1), math.NaN()
+	tiny := math.SmallestNonzeroFloat64
+	for _, test := range []struct {
+		x    []float64
+		want float64
+	}{
+		{x: nil, want: 0},
+		{x: []float64{0, 0}, want: 0},
+		{x: []float64{1, 2, 3, -4}, want: 2},
+		{x: []float64{0x1p-60, 1, -1}, want: 0x1p-60},
+		{x: []float64{math.MaxFloat64, math.MaxFloat64}, want: inf},
+		{x: []float64{math.MaxFloat64, -math.MaxFloat64, math.MaxFloat64}, want: math.MaxFloat64},
+		{x: []float64{tiny, tiny, tiny}, want: 3 * tiny},
+		{x: []float64{1, inf, 2}, want: inf},
+		{x: []float64{1, -inf}, want: -inf},
+		{x: []float64{inf, -inf}, want: nan},
+		{x: []float64{1, nan, inf}, want: nan},
+	} {
+		dst := make([]float64, len(test.x))
+		ReproCumSum(dst, test.x)
+		var got float64
+		if len(dst) != 0 {
+			got = dst[len(dst)-1]
+		}
+		if !identical(got, test.want) {
+			t.Errorf("unexpected ReproCumSum result for %v: got %v want %v", test.x, got, test.want)
+		}
+	}
+}
+
+func TestReproGolden(t *testing.T) {
+	// The results must be identical on every architecture and under
+	// every build tag.
+	rnd := rand.New(rand.NewSource(1))
+	x, y := reproVec(1000, rnd), reproVec(1000, rnd)
+	for _, test := range []struct {
+		name string
+		got  float64
+		want uint64
+	}{
+		{name: "ReproDotUnitary", got: ReproDotUnitary(x, y), want: 0x44b8cacf136478d9},
+		{name: "ReproL1Norm", got: ReproL1Norm(x), want: 0x42bbf5df6b37a61d},
+		{name: "ReproCumSum", got: ReproCumSum(make([]float64, len(x)), x)[len(x)/2], want: 0x428eac7c8f74599e},
+	} {
+		if got := math.Float64bits(test.got); got != test.want {
+			t.Errorf("%s: unexpected result: got %#x (%v) want %#x", test.name, got, test.got, test.want)
+		}
+	}
+}
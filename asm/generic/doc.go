@@ -0,0 +1,17 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This repository is no longer maintained.
+// Development has moved to https://github.com/gonum/gonum.
+//
+// Package generic provides type-parameterised vector primitives over
+// float32, float64, complex64 and complex128.
+//
+// Each function dispatches on its element type to the corresponding kernel
+// in asm/f32, asm/f64, asm/c64 or asm/c128 without allocating. Operations
+// that a package does not provide are implemented in Go. The functions
+// follow the naming and argument conventions of the concrete packages.
+//
+// The package requires Go 1.18 or later.
+package generic
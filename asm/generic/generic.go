@@ -0,0 +1,304 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18
+// +build go1.18
+
+package generic
+
+import (
+	"github.com/gonum/internal/asm/c128"
+	"github.com/gonum/internal/asm/c64"
+	"github.com/gonum/internal/asm/f32"
+	"github.com/gonum/internal/asm/f64"
+)
+
+// Element is the set of element types supported by the asm packages.
+type Element interface {
+	float32 | float64 | complex64 | complex128
+}
+
+// AxpyUnitary is
+//  for i, v := range x {
+//  	y[i] += alpha * v
+//  }
+func AxpyUnitary[T Element](alpha T, x, y []T) {
+	switch x := any(x).(type) {
+	case []float32:
+		f32.AxpyUnitary(any(alpha).(float32), x, any(y).([]float32))
+	case []float64:
+		f64.AxpyUnitary(any(alpha).(float64), x, any(y).([]float64))
+	case []complex64:
+		c64.AxpyUnitary(any(alpha).(complex64), x, any(y).([]complex64))
+	case []complex128:
+		c128.AxpyUnitary(any(alpha).(complex128), x, any(y).([]complex128))
+	}
+}
+
+// AxpyUnitaryTo is
+//  for i, v := range x {
+//  	dst[i] = alpha*v + y[i]
+//  }
+func AxpyUnitaryTo[T Element](dst []T, alpha T, x, y []T) {
+	switch x := any(x).(type) {
+	case []float32:
+		f32.AxpyUnitaryTo(any(dst).([]float32), any(alpha).(float32), x, any(y).([]float32))
+	case []float64:
+		f64.AxpyUnitaryTo(any(dst).([]float64), any(alpha).(float64), x, any(y).([]float64))
+	case []complex64:
+		c64.AxpyUnitaryTo(any(dst).([]complex64), any(alpha).(complex64), x, any(y).([]complex64))
+	case []complex128:
+		c128.AxpyUnitaryTo(any(dst).([]complex128), any(alpha).(complex128), x, any(y).([]complex128))
+	}
+}
+
+// AxpyInc is
+//  for i := 0; i < int(n); i++ {
+//  	y[iy] += alpha * x[ix]
+//  	ix += incX
+//  	iy += incY
+//  }
+func AxpyInc[T Element](alpha T, x, y []T, n, incX, incY, ix, iy uintptr) {
+	switch x := any(x).(type) {
+	case []float32:
+		f32.AxpyInc(any(alpha).(float32), x, any(y).([]float32), n, incX, incY, ix, iy)
+	case []float64:
+		f64.AxpyInc(any(alpha).(float64), x, any(y).([]float64), n, incX, incY, ix, iy)
+	case []complex64:
+		c64.AxpyInc(any(alpha).(complex64), x, any(y).([]complex64), n, incX, incY, ix, iy)
+	case []complex128:
+		c128.AxpyInc(any(alpha).(complex128), x, any(y).([]complex128), n, incX, incY, ix, iy)
+	}
+}
+
+// AxpyIncTo is
+//  for i := 0; i < int(n); i++ {
+//  	dst[idst] = alpha*x[ix] + y[iy]
+//  	ix += incX
+//  	iy += incY
+//  	idst += incDst
+//  }
+func AxpyIncTo[T Element](dst []T, incDst, idst uintptr, alpha T, x, y []T, n, incX, incY, ix, iy uintptr) {
+	switch x := any(x).(type) {
+	case []float32:
+		f32.AxpyIncTo(any(dst).([]float32), incDst, idst, any(alpha).(float32), x, any(y).([]float32), n, incX, incY, ix, iy)
+	case []float64:
+		f64.AxpyIncTo(any(dst).([]float64), incDst, idst, any(alpha).(float64), x, any(y).([]float64), n, incX, incY, ix, iy)
+	case []complex64:
+		c64.AxpyIncTo(any(dst).([]complex64), incDst, idst, any(alpha).(complex64), x, any(y).([]complex64), n, incX, incY, ix, iy)
+	case []complex128:
+		c128.AxpyIncTo(any(dst).([]complex128), incDst, idst, any(alpha).(complex128), x, any(y).([]complex128), n, incX, incY, ix, iy)
+	}
+}
+
+// DotUnitary is
+//  for i, v := range x {
+//  	sum += y[i] * v
+//  }
+//  return
+// For complex types the elements of x are not conjugated.
+func DotUnitary[T Element](x, y []T) (sum T) {
+	switch x := any(x).(type) {
+	case []float32:
+		*any(&sum).(*float32) = f32.DotUnitary(x, any(y).([]float32))
+	case []float64:
+		*any(&sum).(*float64) = f64.DotUnitary(x, any(y).([]float64))
+	case []complex64:
+		*any(&sum).(*complex64) = c64.DotuUnitary(x, any(y).([]complex64))
+	case []complex128:
+		*any(&sum).(*complex128) = c128.DotuUnitary(x, any(y).([]complex128))
+	}
+	return
+}
+
+// DotInc is
+//  for i := 0; i < int(n); i++ {
+//  	sum += y[iy] * x[ix]
+//  	ix += incX
+//  	iy += incY
+//  }
+//  return
+// For complex types the elements of x are not conjugated.
+func DotInc[T Element](x, y []T, n, incX, incY, ix, iy uintptr) (sum T) {
+	switch x := any(x).(type) {
+	case []float32:
+		*any(&sum).(*float32) = f32.DotInc(x, any(y).([]float32), n, incX, incY, ix, iy)
+	case []float64:
+		*any(&sum).(*float64) = f64.DotInc(x, any(y).([]float64), n, incX, incY, ix, iy)
+	case []complex64:
+		*any(&sum).(*complex64) = c64.DotuInc(x, any(y).([]complex64), n, incX, incY, ix, iy)
+	case []complex128:
+		*any(&sum).(*complex128) = c128.DotuInc(x, any(y).([]complex128), n, incX, incY, ix, iy)
+	}
+	return
+}
+
+// DotcUnitary is
+//  for i, v := range x {
+//  	sum += y[i] * conj(v)
+//  }
+//  return
+// For real types it is equivalent to DotUnitary.
+func DotcUnitary[T Element](x, y []T) (sum T) {
+	switch x := any(x).(type) {
+	case []float32:
+		*any(&sum).(*float32) = f32.DotUnitary(x, any(y).([]float32))
+	case []float64:
+		*any(&sum).(*float64) = f64.DotUnitary(x, any(y).([]float64))
+	case []complex64:
+		*any(&sum).(*complex64) = c64.DotcUnitary(x, any(y).([]complex64))
+	case []complex128:
+		*any(&sum).(*complex128) = c128.DotcUnitary(x, any(y).([]complex128))
+	}
+	return
+}
+
+// DotcInc is
+//  for i := 0; i < int(n); i++ {
+//  	sum += y[iy] * conj(x[ix])
+//  	ix += incX
+//  	iy += incY
+//  }
+//  return
+// For real types it is equivalent to DotInc.
+func DotcInc[T Element](x, y []T, n, incX, incY, ix, iy uintptr) (sum T) {
+	switch x := any(x).(type) {
+	case []float32:
+		*any(&sum).(*float32) = f32.DotInc(x, any(y).([]float32), n, incX, incY, ix, iy)
+	case []float64:
+		*any(&sum).(*float64) = f64.DotInc(x, any(y).([]float64), n, incX, incY, ix, iy)
+	case []complex64:
+		*any(&sum).(*complex64) = c64.DotcInc(x, any(y).([]complex64), n, incX, incY, ix, iy)
+	case []complex128:
+		*any(&sum).(*complex128) = c128.DotcInc(x, any(y).([]complex128), n, incX, incY, ix, iy)
+	}
+	return
+}
+
+// ScalUnitary is
+//  for i := range x {
+//  	x[i] *= alpha
+//  }
+func ScalUnitary[T Element](alpha T, x []T) {
+	switch x := any(x).(type) {
+	case []float32:
+		f32.ScalUnitary(any(alpha).(float32), x)
+	case []float64:
+		f64.ScalUnitary(any(alpha).(float64), x)
+	case []complex64:
+		c64.ScalUnitary(any(alpha).(complex64), x)
+	case []complex128:
+		c128.ScalUnitary(any(alpha).(complex128), x)
+	}
+}
+
+// ScalUnitaryTo is
+//  for i, v := range x {
+//  	dst[i] = alpha * v
+//  }
+func ScalUnitaryTo[T Element](dst []T, alpha T, x []T) {
+	switch x := any(x).(type) {
+	case []float32:
+		f32.ScalUnitaryTo(any(dst).([]float32), any(alpha).(float32), x)
+	case []float64:
+		f64.ScalUnitaryTo(any(dst).([]float64), any(alpha).(float64), x)
+	case []complex64:
+		c64.ScalUnitaryTo(any(dst).([]complex64), any(alpha).(complex64), x)
+	case []complex128:
+		c128.ScalUnitaryTo(any(dst).([]complex128), any(alpha).(complex128), x)
+	}
+}
+
+// ScalInc is
+//  var ix uintptr
+//  for i := 0; i < int(n); i++ {
+//  	x[ix] *= alpha
+//  	ix += incX
+//  }
+func ScalInc[T Element](alpha T, x []T, n, incX uintptr) {
+	switch x := any(x).(type) {
+	case []float32:
+		f32.ScalInc(any(alpha).(float32), x, n, incX)
+	case []float64:
+		f64.ScalInc(any(alpha).(float64), x, n, incX)
+	case []complex64:
+		c64.ScalInc(any(alpha).(complex64), x, n, incX)
+	case []complex128:
+		c128.ScalInc(any(alpha).(complex128), x, n, incX)
+	}
+}
+
+// ScalIncTo is
+//  var idst, ix uintptr
+//  for i := 0; i < int(n); i++ {
+//  	dst[idst] = alpha * x[ix]
+//  	ix += incX
+//  	idst += incDst
+//  }
+func ScalIncTo[T Element](dst []T, incDst uintptr, alpha T, x []T, n, incX uintptr) {
+	switch x := any(x).(type) {
+	case []float32:
+		f32.ScalIncTo(any(dst).([]float32), incDst, any(alpha).(float32), x, n, incX)
+	case []float64:
+		f64.ScalIncTo(any(dst).([]float64), incDst, any(alpha).(float64), x, n, incX)
+	case []complex64:
+		c64.ScalIncTo(any(dst).([]complex64), incDst, any(alpha).(complex64), x, n, incX)
+	case []complex128:
+		c128.ScalIncTo(any(dst).([]complex128), incDst, any(alpha).(complex128), x, n, incX)
+	}
+}
+
+// Add is
+//  for i, v := range s {
+//  	dst[i] += v
+//  }
+func Add[T Element](dst, s []T) {
+	switch s := any(s).(type) {
+	case []float64:
+		f64.Add(any(dst).([]float64), s)
+		return
+	}
+	for i, v := range s {
+		dst[i] += v
+	}
+}
+
+// Mul is
+//  for i, v := range s {
+//  	dst[i] *= v
+//  }
+func Mul[T Element](dst, s []T) {
+	switch s := any(s).(type) {
+	case []complex64:
+		c64.Mul(any(dst).([]complex64), s)
+		return
+	case []complex128:
+		c128.Mul(any(dst).([]complex128), s)
+		return
+	}
+	for i, v := range s {
+		dst[i] *= v
+	}
+}
+
+// Div is
+//  for i, v := range s {
+//  	dst[i] /= v
+//  }
+func Div[T Element](dst, s []T) {
+	switch s := any(s).(type) {
+	case []float64:
+		f64.Div(any(dst).([]float64), s)
+		return
+	case []complex64:
+		c64.Div(any(dst).([]complex64), s)
+		return
+	case []complex128:
+		c128.Div(any(dst).([]complex128), s)
+		return
+	}
+	for i, v := range s {
+		dst[i] /= v
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18
+// +build go1.18
+
+package generic
+
+import (
+	"math/cmplx"
+	"math/rand"
+	"testing"
+
+	"github.com/gonum/internal/asm/c128"
+	"github.com/gonum/internal/asm/c64"
+	"github.com/gonum/internal/asm/f32"
+	"github.com/gonum/internal/asm/f64"
+)
+
+func randVec[T Element](rnd *rand.Rand, n int) []T {
+	v := make([]T, n)
+	for i := range v {
+		var e T
+		switch p := any(&e).(type) {
+		case *float32:
+			*p = float32(rnd.NormFloat64())
+		case *float64:
+			*p = rnd.NormFloat64()
+		case *complex64:
+			*p = complex(float32(rnd.NormFloat64()), float32(rnd.NormFloat64()))
+		case *complex128:
+			*p = complex(rnd.NormFloat64(), rnd.NormFloat64())
+		}
+		// Keep divisors away from zero.
+		v[i] = e + 3
+	}
+	return v
+}
+
+func same[T Element](a, b T) bool {
+	switch a := any(a).(type) {
+	case complex64:
+		b := any(b).(complex64)
+		return a == b || cmplx.IsNaN(complex128(a)) && cmplx.IsNaN(complex128(b))
+	case complex128:
+		b := any(b).(complex128)
+		return a == b || cmplx.IsNaN(a) && cmplx.IsNaN(b)
+	}
+	return a == b || a != a && b != b
+}
+
+func sameVec[T Element](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !same(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func clone[T Element](s []T) []T {
+	return append([]T(nil), s...)
+}
+
+// testElementwise checks the element-wise functions, which have Go
+// fallbacks for some types, against direct loops.
+func testElementwise[T Element](t *testing.T, name string) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 3, 4, 7, 16, 33} {
+		dst, s := randVec[T](rnd, n), randVec[T](rnd, n)
+		for _, test := range []struct {
+			op   string
+			fn   func(dst, s []T)
+			want func(d, v T) T
+		}{
+			{"Add", Add[T], func(d, v T) T { return d + v }},
+			{"Mul", Mul[T], func(d, v T) T { return d * v }},
+			{"Div", Div[T], func(d, v T) T { return d / v }},
+		} {
+			got := clone(dst)
+			test.fn(got, s)
+			want := clone(dst)
+			for i, v := range s {
+				want[i] = test.want(want[i], v)
+			}
+			if !sameVec(got, want) {
+				t.Errorf("%s %s n=%d: unexpected result:\ngot: %v\nwant:%v", name, test.op, n, got, want)
+			}
+		}
+	}
+}
+
+func TestElementwise(t *testing.T) {
+	testElementwise[float32](t, "float32")
+	testElementwise[float64](t, "float64")
+	testElementwise[complex64](t, "complex64")
+	testElementwise[complex128](t, "complex128")
+}
+
+func TestDispatch(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	const n, inc = 17, 2
+
+	x32, y32 := randVec[float32](rnd, n*inc), randVec[float32](rnd, n*inc)
+	x64, y64 := randVec[float64](rnd, n*inc), randVec[float64](rnd, n*inc)
+	xc64, yc64 := randVec[complex64](rnd, n*inc), randVec[complex64](rnd, n*inc)
+	xc128, yc128 := randVec[complex128](rnd, n*inc), randVec[complex128](rnd, n*inc)
+
+	for _, test := range []struct {
+		name      string
+		got, want interface{}
+	}{
+		{"DotUnitary float32", DotUnitary(x32, y32), f32.DotUnitary(x32, y32)},
+		{"DotUnitary float64", DotUnitary(x64, y64), f64.DotUnitary(x64, y64)},
+		{"DotUnitary complex64", DotUnitary(xc64, yc64), c64.DotuUnitary(xc64, yc64)},
+		{"DotUnitary complex128", DotUnitary(xc128, yc128), c128.DotuUnitary(xc128, yc128)},
+		{"DotcUnitary float32", DotcUnitary(x32, y32), f32.DotUnitary(x32, y32)},
+		{"DotcUnitary float64", DotcUnitary(x64, y64), f64.DotUnitary(x64, y64)},
+		{"DotcUnitary complex64", DotcUnitary(xc64, yc64), c64.DotcUnitary(xc64, yc64)},
+		{"DotcUnitary complex128", DotcUnitary(xc128, yc128), c128.DotcUnitary(xc128, yc128)},
+		{"DotInc float32", DotInc(x32, y32, n, inc, inc, 0, 0), f32.DotInc(x32, y32, n, inc, inc, 0, 0)},
+		{"DotInc float64", DotInc(x64, y64, n, inc, inc, 0, 0), f64.DotInc(x64, y64, n, inc, inc, 0, 0)},
+		{"DotInc complex64", DotInc(xc64, yc64, n, inc, inc, 0, 0), c64.DotuInc(xc64, yc64, n, inc, inc, 0, 0)},
+		{"DotInc complex128", DotInc(xc128, yc128, n, inc, inc, 0, 0), c128.DotuInc(xc128, yc128, n, inc, inc, 0, 0)},
+		{"DotcInc complex64", DotcInc(xc64, yc64, n, inc, inc, 0, 0), c64.DotcInc(xc64, yc64, n, inc, inc, 0, 0)},
+		{"DotcInc complex128", DotcInc(xc128, yc128, n, inc, inc, 0, 0), c128.DotcInc(xc128, yc128, n, inc, inc, 0, 0)},
+	} {
+		if test.got != test.want {
+			t.Errorf("%s: unexpected result: got %v want %v", test.name, test.got, test.want)
+		}
+	}
+
+	testVectorOps(t, "float32", x32, y32, 1.5,
+		f32.AxpyUnitary, f32.AxpyInc, f32.ScalUnitary, f32.ScalInc)
+	testVectorOps(t, "float64", x64, y64, 1.5,
+		f64.AxpyUnitary, f64.AxpyInc, f64.ScalUnitary, f64.ScalInc)
+	testVectorOps(t, "complex64", xc64, yc64, 1.5-2i,
+		c64.AxpyUnitary, c64.AxpyInc, c64.ScalUnitary, c64.ScalInc)
+	testVectorOps(t, "complex128", xc128, yc128, 1.5-2i,
+		c128.AxpyUnitary, c128.AxpyInc, c128.ScalUnitary, c128.ScalInc)
+}
+
+func testVectorOps[T Element](t *testing.T, name string, x, y []T, alpha T,
+	axpyUnitary func(T, []T, []T),
+	axpyInc func(T, []T, []T, uintptr, uintptr, uintptr, uintptr, uintptr),
+	scalUnitary func(T, []T),
+	scalInc func(T, []T, uintptr, uintptr),
+) {
+	n := uintptr(len(x) / 2)
+
+	got, want := clone(y), clone(y)
+	AxpyUnitary(alpha, x, got)
+	axpyUnitary(alpha, x, want)
+	if !sameVec(got, want) {
+		t.Errorf("%s: unexpected AxpyUnitary result", name)
+	}
+
+	got, want = clone(y), clone(y)
+	AxpyInc(alpha, x, got, n, 2, 2, 0, 0)
+	axpyInc(alpha, x, want, n, 2, 2, 0, 0)
+	if !sameVec(got, want) {
+		t.Errorf("%s: unexpected AxpyInc result", name)
+	}
+
+	dst := make([]T, len(x))
+	want = clone(y)
+	AxpyUnitaryTo(dst, alpha, x, y)
+	axpyUnitary(alpha, x, want)
+	if !sameVec(dst, want) {
+		t.Errorf("%s: unexpected AxpyUnitaryTo result", name)
+	}
+
+	got, want = clone(x), clone(x)
+	ScalUnitary(alpha, got)
+	scalUnitary(alpha, want)
+	if !sameVec(got, want) {
+		t.Errorf("%s: unexpected ScalUnitary result", name)
+	}
+
+	got, want = clone(x), clone(x)
+	ScalInc(alpha, got, n, 2)
+	scalInc(alpha, want, n, 2)
+	if !sameVec(got, want) {
+		t.Errorf("%s: unexpected ScalInc result", name)
+	}
+
+	dst = make([]T, len(x))
+	want = clone(x)
+	ScalUnitaryTo(dst, alpha, x)
+	scalUnitary(alpha, want)
+	if !sameVec(dst, want) {
+		t.Errorf("%s: unexpected ScalUnitaryTo result", name)
+	}
+}
+
+func testAllocs[T Element](t *testing.T, name string) {
+	rnd := rand.New(rand.NewSource(1))
+	x, y := randVec[T](rnd, 16), randVec[T](rnd, 16)
+	var alpha T = 2
+	allocs := testing.AllocsPerRun(10, func() {
+		AxpyUnitary(alpha, x, y)
+		AxpyInc(alpha, x, y, 8, 2, 2, 0, 0)
+		DotUnitary(x, y)
+		DotcInc(x, y, 8, 2, 2, 0, 0)
+		ScalUnitary(alpha, x)
+		ScalIncTo(y, 1, alpha, x, 8, 2)
+		Add(y, x)
+		Mul(y, x)
+		Div(y, x)
+	})
+	if allocs != 0 {
+		t.Errorf("%s: unexpected allocations: got %v want 0", name, allocs)
+	}
+}
+
+func TestAllocs(t *testing.T) {
+	testAllocs[float32](t, "float32")
+	testAllocs[float64](t, "float64")
+	testAllocs[complex64](t, "complex64")
+	testAllocs[complex128](t, "complex128")
+}
@@ -0,0 +1,21 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This repository is no longer maintained.
+// Development has moved to https://github.com/gonum/gonum.
+//
+// Package i32 provides int32 and int8 vector primitives.
+//
+// All arithmetic is performed modulo 2^32, as for Go int32 values: results
+// that overflow wrap around and never saturate. Because wrapped addition is
+// associative, every implementation of a kernel returns the same result
+// regardless of the order in which it accumulates.
+//
+// The int8 dot products widen each element to int32 before multiplying,
+// so individual products are exact and only the int32 running sum can
+// wrap, which requires more than 2^17 products of magnitude 2^14.
+//
+// On amd64 the unitary kernels use SSE4.1, and the int8 dot product uses
+// AVX2, when the processor supports them.
+package i32
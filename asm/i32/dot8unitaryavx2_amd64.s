@@ -0,0 +1,77 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+#include "textflag.h"
+
+// VPXOR Y4, Y4, Y4
+#define VPXOR_Y4_Y4_Y4 BYTE $0xC5; BYTE $0xDD; BYTE $0xEF; BYTE $0xE4
+// VPXOR Y5, Y5, Y5
+#define VPXOR_Y5_Y5_Y5 BYTE $0xC5; BYTE $0xD5; BYTE $0xEF; BYTE $0xED
+
+// VPMOVSXBW (SI)(AX*1), Y0
+#define VPMOVSXBW_SI_Y0 BYTE $0xC4; BYTE $0xE2; BYTE $0x7D; BYTE $0x20; BYTE $0x04; BYTE $0x06
+// VPMOVSXBW 16(SI)(AX*1), Y1
+#define VPMOVSXBW_16SI_Y1 BYTE $0xC4; BYTE $0xE2; BYTE $0x7D; BYTE $0x20; BYTE $0x4C; BYTE $0x06; BYTE $0x10
+// VPMOVSXBW (DI)(AX*1), Y2
+#define VPMOVSXBW_DI_Y2 BYTE $0xC4; BYTE $0xE2; BYTE $0x7D; BYTE $0x20; BYTE $0x14; BYTE $0x07
+// VPMOVSXBW 16(DI)(AX*1), Y3
+#define VPMOVSXBW_16DI_Y3 BYTE $0xC4; BYTE $0xE2; BYTE $0x7D; BYTE $0x20; BYTE $0x5C; BYTE $0x07; BYTE $0x10
+
+// VPMADDWD Y2, Y0, Y0
+#define VPMADDWD_Y2_Y0_Y0 BYTE $0xC5; BYTE $0xFD; BYTE $0xF5; BYTE $0xC2
+// VPMADDWD Y3, Y1, Y1
+#define VPMADDWD_Y3_Y1_Y1 BYTE $0xC5; BYTE $0xF5; BYTE $0xF5; BYTE $0xCB
+
+// VPADDD Y0, Y4, Y4
+#define VPADDD_Y0_Y4_Y4 BYTE $0xC5; BYTE $0xDD; BYTE $0xFE; BYTE $0xE0
+// VPADDD Y1, Y5, Y5
+#define VPADDD_Y1_Y5_Y5 BYTE $0xC5; BYTE $0xD5; BYTE $0xFE; BYTE $0xE9
+// VPADDD Y5, Y4, Y4
+#define VPADDD_Y5_Y4_Y4 BYTE $0xC5; BYTE $0xDD; BYTE $0xFE; BYTE $0xE5
+
+// VEXTRACTI128 $1, Y4, X5
+#define VEXTRACTI128_Y4_X5 BYTE $0xC4; BYTE $0xE3; BYTE $0x7D; BYTE $0x39; BYTE $0xE5; BYTE $0x01
+
+// VZEROUPPER
+#define VZEROUPPER BYTE $0xC5; BYTE $0xF8; BYTE $0x77
+
+// func dot8UnitaryAVX2(x, y []int8) (sum int32)
+TEXT ·dot8UnitaryAVX2(SB), NOSPLIT, $0
+	MOVQ           x_base+0(FP), SI  // SI = &x
+	MOVQ           y_base+24(FP), DI // DI = &y
+	MOVQ           x_len+8(FP), CX   // CX = len(x)
+	VPXOR_Y4_Y4_Y4                   // Clear accumulators
+	VPXOR_Y5_Y5_Y5
+	SHRQ           $5, CX            // CX = len / 32
+	JZ             bdotavx_end       // if CX == 0 { return 0 }
+	XORQ           AX, AX            // i = 0
+
+bdotavx_loop: // Loop unrolled 32x   do {
+	VPMOVSXBW_SI_Y0 // Y_i = int16(x[i:i+16])
+	VPMOVSXBW_16SI_Y1
+	VPMOVSXBW_DI_Y2 // Y_(i+2) = int16(y[i:i+16])
+	VPMOVSXBW_16DI_Y3
+
+	// Y_i = { Y_i[15]*Y_(i+2)[15] + Y_i[14]*Y_(i+2)[14], ... } as int32
+	VPMADDWD_Y2_Y0_Y0
+	VPMADDWD_Y3_Y1_Y1
+	VPADDD_Y0_Y4_Y4                // Y_(i+4) += Y_i
+	VPADDD_Y1_Y5_Y5
+	ADDQ              $32, AX      // i += 32
+	LOOP              bdotavx_loop // } while --CX > 0
+
+bdotavx_end:
+	VPADDD_Y5_Y4_Y4                  // Combine accumulators
+	VEXTRACTI128_Y4_X5               // X5 = Y4[4:8]
+	VZEROUPPER
+	PADDL              X5, X4        // X4 += X5
+	PSHUFL             $0x4E, X4, X5 // X5 = { X4[1], X4[0], X4[3], X4[2] }
+	PADDL              X5, X4
+	PSHUFL             $0xB1, X4, X5 // X5 = { X4[2], X4[3], X4[0], X4[1] }
+	PADDL              X5, X4        // X4[0] = sum(X4)
+	MOVQ               X4, AX
+	MOVL               AX, sum+48(FP)
+	RET
@@ -0,0 +1,51 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+#include "textflag.h"
+
+// PMOVSXBW (SI)(AX*1), X0
+#define PMOVSXBW_SI_X0 BYTE $0x66; BYTE $0x0F; BYTE $0x38; BYTE $0x20; BYTE $0x04; BYTE $0x06
+// PMOVSXBW 8(SI)(AX*1), X1
+#define PMOVSXBW_8SI_X1 BYTE $0x66; BYTE $0x0F; BYTE $0x38; BYTE $0x20; BYTE $0x4C; BYTE $0x06; BYTE $0x08
+// PMOVSXBW (DI)(AX*1), X2
+#define PMOVSXBW_DI_X2 BYTE $0x66; BYTE $0x0F; BYTE $0x38; BYTE $0x20; BYTE $0x14; BYTE $0x07
+// PMOVSXBW 8(DI)(AX*1), X3
+#define PMOVSXBW_8DI_X3 BYTE $0x66; BYTE $0x0F; BYTE $0x38; BYTE $0x20; BYTE $0x5C; BYTE $0x07; BYTE $0x08
+
+// func dot8UnitarySSE4(x, y []int8) (sum int32)
+TEXT ·dot8UnitarySSE4(SB), NOSPLIT, $0
+	MOVQ x_base+0(FP), SI  // SI = &x
+	MOVQ y_base+24(FP), DI // DI = &y
+	MOVQ x_len+8(FP), CX   // CX = len(x)
+	PXOR X4, X4            // Clear accumulators
+	PXOR X5, X5
+	SHRQ $4, CX            // CX = len / 16
+	JZ   bdot_end          // if CX == 0 { return 0 }
+	XORQ AX, AX            // i = 0
+
+bdot_loop: // Loop unrolled 16x   do {
+	PMOVSXBW_SI_X0 // X_i = int16(x[i:i+8])
+	PMOVSXBW_8SI_X1
+	PMOVSXBW_DI_X2 // X_(i+2) = int16(y[i:i+8])
+	PMOVSXBW_8DI_X3
+
+	// X_i = { X_i[7]*X_(i+2)[7] + X_i[6]*X_(i+2)[6], ... } as int32
+	PMADDWL X2, X0
+	PMADDWL X3, X1
+	PADDL   X0, X4    // X_(i+4) += X_i
+	PADDL   X1, X5
+	ADDQ    $16, AX   // i += 16
+	LOOP    bdot_loop // } while --CX > 0
+	PADDL   X5, X4    // Combine accumulators
+
+bdot_end:
+	PSHUFL $0x4E, X4, X5 // X5 = { X4[1], X4[0], X4[3], X4[2] }
+	PADDL  X5, X4
+	PSHUFL $0xB1, X4, X5 // X5 = { X4[2], X4[3], X4[0], X4[1] }
+	PADDL  X5, X4        // X4[0] = sum(X4)
+	MOVQ   X4, AX
+	MOVL   AX, sum+48(FP)
+	RET
@@ -0,0 +1,45 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+#include "textflag.h"
+
+// PMULLD X2, X0
+#define PMULLD_X2_X0 BYTE $0x66; BYTE $0x0F; BYTE $0x38; BYTE $0x40; BYTE $0xC2
+// PMULLD X3, X1
+#define PMULLD_X3_X1 BYTE $0x66; BYTE $0x0F; BYTE $0x38; BYTE $0x40; BYTE $0xCB
+
+// func dotUnitarySSE4(x, y []int32) (sum int32)
+TEXT ·dotUnitarySSE4(SB), NOSPLIT, $0
+	MOVQ x_base+0(FP), SI  // SI = &x
+	MOVQ y_base+24(FP), DI // DI = &y
+	MOVQ x_len+8(FP), CX   // CX = len(x)
+	PXOR X4, X4            // Clear accumulators
+	PXOR X5, X5
+	SHRQ $3, CX            // CX = len / 8
+	JZ   idot_end          // if CX == 0 { return 0 }
+	XORQ AX, AX            // i = 0
+
+idot_loop: // Loop unrolled 8x   do {
+	MOVOU        (SI)(AX*4), X0 // X_i = x[i:i+4]
+	MOVOU        16(SI)(AX*4), X1
+	MOVOU        (DI)(AX*4), X2 // X_(i+2) = y[i:i+4]
+	MOVOU        16(DI)(AX*4), X3
+	PMULLD_X2_X0                // X_i *= X_(i+2)
+	PMULLD_X3_X1
+	PADDL        X0, X4         // X_(i+4) += X_i
+	PADDL        X1, X5
+	ADDQ         $8, AX         // i += 8
+	LOOP         idot_loop      // } while --CX > 0
+	PADDL        X5, X4         // Combine accumulators
+
+idot_end:
+	PSHUFL $0x4E, X4, X5 // X5 = { X4[1], X4[0], X4[3], X4[2] }
+	PADDL  X5, X4
+	PSHUFL $0xB1, X4, X5 // X5 = { X4[2], X4[3], X4[0], X4[1] }
+	PADDL  X5, X4        // X4[0] = sum(X4)
+	MOVQ   X4, AX
+	MOVL   AX, sum+48(FP)
+	RET
@@ -0,0 +1,100 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+package i32
+
+var (
+	useSSE4 = hasSSE4()
+	useAVX2 = hasAVX2()
+)
+
+func hasSSE4() bool {
+	const sse41 = 1 << 19
+	_, _, ecx, _ := cpuid(1, 0)
+	return ecx&sse41 != 0
+}
+
+func hasAVX2() bool {
+	const (
+		osxsave = 1 << 27
+		avx     = 1 << 28
+		avx2    = 1 << 5
+	)
+	maxID, _, _, _ := cpuid(0, 0)
+	if maxID < 7 {
+		return false
+	}
+	_, _, ecx, _ := cpuid(1, 0)
+	if ecx&(osxsave|avx) != osxsave|avx {
+		return false
+	}
+	_, ebx, _, _ := cpuid(7, 0)
+	if ebx&avx2 == 0 {
+		return false
+	}
+	// Check that the OS has enabled XMM and YMM state saving.
+	eax, _ := xgetbv()
+	return eax&0x6 == 0x6
+}
+
+// DotUnitary is
+//  for i, v := range x {
+//  	sum += y[i] * v
+//  }
+//  return
+func DotUnitary(x, y []int32) (sum int32) {
+	if useSSE4 {
+		n := len(x) &^ 7
+		sum = dotUnitarySSE4(x[:n], y[:n])
+		x, y = x[n:], y[n:]
+	}
+	return sum + dotUnitary(x, y)
+}
+
+// AxpyUnitary is
+//  for i, v := range x {
+//  	y[i] += alpha * v
+//  }
+func AxpyUnitary(alpha int32, x, y []int32) {
+	if useSSE4 {
+		n := len(x) &^ 7
+		axpyUnitarySSE4(alpha, x[:n], y[:n])
+		x, y = x[n:], y[n:]
+	}
+	axpyUnitary(alpha, x, y)
+}
+
+// ScalUnitary is
+//  for i := range x {
+//  	x[i] *= alpha
+//  }
+func ScalUnitary(alpha int32, x []int32) {
+	if useSSE4 {
+		n := len(x) &^ 7
+		scalUnitarySSE4(alpha, x[:n])
+		x = x[n:]
+	}
+	scalUnitary(alpha, x)
+}
+
+// Dot8Unitary is
+//  for i, v := range x {
+//  	sum += int32(y[i]) * int32(v)
+//  }
+//  return
+func Dot8Unitary(x, y []int8) (sum int32) {
+	switch {
+	case useAVX2:
+		n := len(x) &^ 31
+		sum = dot8UnitaryAVX2(x[:n], y[:n])
+		x, y = x[n:], y[n:]
+	case useSSE4:
+		n := len(x) &^ 15
+		sum = dot8UnitarySSE4(x[:n], y[:n])
+		x, y = x[n:], y[n:]
+	}
+	return sum + dot8Unitary(x, y)
+}
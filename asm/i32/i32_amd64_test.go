@@ -0,0 +1,28 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+package i32
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// TestDot8SSE4 checks the SSE4.1 int8 kernel, which Dot8Unitary does not
+// use when AVX2 is available.
+func TestDot8SSE4(t *testing.T) {
+	if !useSSE4 {
+		t.Skip("SSE4.1 not available")
+	}
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 16, 32, 48, 1024} {
+		x, y := randInt8s(rnd, n+1), randInt8s(rnd, n+1)
+		want := dot8Unitary(x[1:], y[1:])
+		if got := dot8UnitarySSE4(x[1:], y[1:]); got != want {
+			t.Errorf("n=%d: unexpected dot8UnitarySSE4 result: got %d want %d", n, got, want)
+		}
+	}
+}
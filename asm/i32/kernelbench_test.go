@@ -0,0 +1,116 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18
+// +build go1.18
+
+package i32
+
+import (
+	"testing"
+
+	"github.com/gonum/internal/asm/internal/asmtest"
+)
+
+// benchKernel benchmarks a kernel with vectors allocated by setup.
+type benchKernel struct {
+	name  string
+	incs  []int
+	cost  asmtest.Cost
+	setup func(n, inc int) func()
+}
+
+// BenchmarkKernels benchmarks every kernel over a range of lengths and
+// increments, reporting GFLOP/s and GB/s.
+func BenchmarkKernels(b *testing.B) {
+	for _, k := range benchKernels {
+		b.Run(k.name, func(b *testing.B) {
+			asmtest.Bench(b, k.incs, k.cost, k.setup)
+		})
+	}
+}
+
+// benchVector returns a vector of n elements with increment inc for a
+// benchmark. All of its elements are one.
+func benchVector[T any](n, inc int, one T) []T {
+	if inc < 0 {
+		inc = -inc
+	}
+	var span int
+	if n > 0 {
+		span = (n-1)*inc + 1
+	}
+	x := make([]T, span)
+	for i := range x {
+		x[i] = one
+	}
+	return x
+}
+
+// kernelSink receives the results of the benchmarked reductions.
+var kernelSink int32
+
+// The cost of the kernels counts integer operations as flops.
+var benchKernels = []benchKernel{
+	{name: "DotUnitary", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 2, Bytes: 2 * 4}, setup: func(n, _ int) func() {
+		x := benchVector(n, 1, int32(1))
+		y := benchVector(n, 1, int32(1))
+		return func() {
+			kernelSink = DotUnitary(x, y)
+		}
+	}},
+	{name: "DotInc", incs: asmtest.BenchIncs, cost: asmtest.Cost{Flops: 2, Bytes: 2 * 4}, setup: func(n, inc int) func() {
+		x := benchVector(n, inc, int32(1))
+		y := benchVector(n, -inc, int32(1))
+		return func() {
+			kernelSink = DotInc(x, y, uintptr(n), uintptr(inc), uintptr(-inc), uintptr(asmtest.Start(n, inc)), uintptr(asmtest.Start(n, -inc)))
+		}
+	}},
+	{name: "Dot8Unitary", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 2, Bytes: 2 * 1}, setup: func(n, _ int) func() {
+		x := benchVector(n, 1, int8(1))
+		y := benchVector(n, 1, int8(1))
+		return func() {
+			kernelSink = Dot8Unitary(x, y)
+		}
+	}},
+	{name: "Dot8Inc", incs: asmtest.BenchIncs, cost: asmtest.Cost{Flops: 2, Bytes: 2 * 1}, setup: func(n, inc int) func() {
+		x := benchVector(n, inc, int8(1))
+		y := benchVector(n, -inc, int8(1))
+		return func() {
+			kernelSink = Dot8Inc(x, y, uintptr(n), uintptr(inc), uintptr(-inc), uintptr(asmtest.Start(n, inc)), uintptr(asmtest.Start(n, -inc)))
+		}
+	}},
+	{name: "AxpyUnitary", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 2, Bytes: 3 * 4}, setup: func(n, _ int) func() {
+		x := benchVector(n, 1, int32(1))
+		y := benchVector(n, 1, int32(1))
+		return func() {
+			AxpyUnitary(1, x, y)
+		}
+	}},
+	{name: "AxpyInc", incs: asmtest.BenchIncs, cost: asmtest.Cost{Flops: 2, Bytes: 3 * 4}, setup: func(n, inc int) func() {
+		x := benchVector(n, inc, int32(1))
+		y := benchVector(n, -inc, int32(1))
+		return func() {
+			AxpyInc(1, x, y, uintptr(n), uintptr(inc), uintptr(-inc), uintptr(asmtest.Start(n, inc)), uintptr(asmtest.Start(n, -inc)))
+		}
+	}},
+	{name: "ScalUnitary", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 1, Bytes: 2 * 4}, setup: func(n, _ int) func() {
+		x := benchVector(n, 1, int32(1))
+		return func() {
+			ScalUnitary(1, x)
+		}
+	}},
+	{name: "ScalInc", incs: asmtest.BenchPosIncs, cost: asmtest.Cost{Flops: 1, Bytes: 2 * 4}, setup: func(n, inc int) func() {
+		x := benchVector(n, inc, int32(1))
+		return func() {
+			ScalInc(1, x, uintptr(n), uintptr(inc))
+		}
+	}},
+	{name: "Sum", incs: asmtest.BenchUnitaryIncs, cost: asmtest.Cost{Flops: 1, Bytes: 4}, setup: func(n, _ int) func() {
+		x := benchVector(n, 1, int32(1))
+		return func() {
+			kernelSink = Sum(x)
+		}
+	}},
+}
@@ -0,0 +1,93 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package i32
+
+// The unexported functions below are the pure Go unitary kernels. They are
+// called directly by the exported functions when no faster implementation
+// is available, and complete the tails left by the vector implementations.
+
+func dotUnitary(x, y []int32) (sum int32) {
+	for i, v := range x {
+		sum += y[i] * v
+	}
+	return
+}
+
+func axpyUnitary(alpha int32, x, y []int32) {
+	for i, v := range x {
+		y[i] += alpha * v
+	}
+}
+
+func scalUnitary(alpha int32, x []int32) {
+	for i := range x {
+		x[i] *= alpha
+	}
+}
+
+func dot8Unitary(x, y []int8) (sum int32) {
+	for i, v := range x {
+		sum += int32(y[i]) * int32(v)
+	}
+	return
+}
+
+// DotInc is
+//  for i := 0; i < int(n); i++ {
+//  	sum += y[iy] * x[ix]
+//  	ix += incX
+//  	iy += incY
+//  }
+//  return
+func DotInc(x, y []int32, n, incX, incY, ix, iy uintptr) (sum int32) {
+	for i := 0; i < int(n); i++ {
+		sum += y[iy] * x[ix]
+		ix += incX
+		iy += incY
+	}
+	return
+}
+
+// AxpyInc is
+//  for i := 0; i < int(n); i++ {
+//  	y[iy] += alpha * x[ix]
+//  	ix += incX
+//  	iy += incY
+//  }
+func AxpyInc(alpha int32, x, y []int32, n, incX, incY, ix, iy uintptr) {
+	for i := 0; i < int(n); i++ {
+		y[iy] += alpha * x[ix]
+		ix += incX
+		iy += incY
+	}
+}
+
+// ScalInc is
+//  for i := 0; i < int(n); i++ {
+//  	x[i*incX] *= alpha
+//  }
+func ScalInc(alpha int32, x []int32, n, incX uintptr) {
+	var ix uintptr
+	for i := 0; i < int(n); i++ {
+		x[ix] *= alpha
+		ix += incX
+	}
+}
+
+// Dot8Inc is
+//  for i := 0; i < int(n); i++ {
+//  	sum += int32(y[iy]) * int32(x[ix])
+//  	ix += incX
+//  	iy += incY
+//  }
+//  return
+func Dot8Inc(x, y []int8, n, incX, incY, ix, iy uintptr) (sum int32) {
+	for i := 0; i < int(n); i++ {
+		sum += int32(y[iy]) * int32(x[ix])
+		ix += incX
+		iy += incY
+	}
+	return
+}
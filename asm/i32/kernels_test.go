@@ -0,0 +1,171 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package i32
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+var kernelLens = []int{0, 1, 2, 3, 7, 8, 9, 15, 16, 17, 31, 32, 33, 63, 64, 65, 100, 1000}
+
+// randInt32s returns n random values, a quarter of which are extreme
+// enough that sums and products overflow.
+func randInt32s(rnd *rand.Rand, n int) []int32 {
+	x := make([]int32, n)
+	for i := range x {
+		switch rnd.Intn(4) {
+		case 0:
+			x[i] = []int32{math.MaxInt32, math.MinInt32, -1, 1 << 30}[rnd.Intn(4)]
+		default:
+			x[i] = int32(rnd.Uint32())
+		}
+	}
+	return x
+}
+
+func randInt8s(rnd *rand.Rand, n int) []int8 {
+	x := make([]int8, n)
+	for i := range x {
+		if rnd.Intn(4) == 0 {
+			x[i] = []int8{math.MaxInt8, math.MinInt8}[rnd.Intn(2)]
+		} else {
+			x[i] = int8(rnd.Intn(256) - 128)
+		}
+	}
+	return x
+}
+
+func TestSum(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range kernelLens {
+		for _, off := range []int{0, 1} {
+			x := randInt32s(rnd, n+off+1)
+			x[n+off] = 1 << 20 // Must not be included.
+			var want int32
+			for _, v := range x[off : n+off] {
+				want += v
+			}
+			if got := Sum(x[off : n+off]); got != want {
+				t.Errorf("n=%d off=%d: unexpected Sum result: got %d want %d", n, off, got, want)
+			}
+		}
+	}
+}
+
+func TestDot(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range kernelLens {
+		for _, inc := range []struct{ x, y uintptr }{{1, 1}, {2, 3}, {3, 1}} {
+			x, y := randInt32s(rnd, n*int(inc.x)+1), randInt32s(rnd, n*int(inc.y)+1)
+			var want int32
+			for i := 0; i < n; i++ {
+				want += x[i*int(inc.x)] * y[i*int(inc.y)]
+			}
+			if got := DotInc(x, y, uintptr(n), inc.x, inc.y, 0, 0); got != want {
+				t.Errorf("n=%d incX=%d incY=%d: unexpected DotInc result: got %d want %d", n, inc.x, inc.y, got, want)
+			}
+			if inc.x != 1 || inc.y != 1 {
+				continue
+			}
+			// Offset the slices so the vector loads are unaligned.
+			want = 0
+			for i := 0; i < n; i++ {
+				want += x[i+1] * y[i+1]
+			}
+			if got := DotUnitary(x[1:n+1], y[1:n+1]); got != want {
+				t.Errorf("n=%d: unexpected DotUnitary result: got %d want %d", n, got, want)
+			}
+		}
+	}
+}
+
+func TestDot8(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range append(kernelLens, 1<<18) {
+		for _, inc := range []struct{ x, y uintptr }{{1, 1}, {2, 3}} {
+			x, y := randInt8s(rnd, n*int(inc.x)+1), randInt8s(rnd, n*int(inc.y)+1)
+			var want int32
+			for i := 0; i < n; i++ {
+				want += int32(x[i*int(inc.x)]) * int32(y[i*int(inc.y)])
+			}
+			if got := Dot8Inc(x, y, uintptr(n), inc.x, inc.y, 0, 0); got != want {
+				t.Errorf("n=%d incX=%d incY=%d: unexpected Dot8Inc result: got %d want %d", n, inc.x, inc.y, got, want)
+			}
+			if inc.x != 1 || inc.y != 1 {
+				continue
+			}
+			want = 0
+			for i := 0; i < n; i++ {
+				want += int32(x[i+1]) * int32(y[i+1])
+			}
+			if got := Dot8Unitary(x[1:n+1], y[1:n+1]); got != want {
+				t.Errorf("n=%d: unexpected Dot8Unitary result: got %d want %d", n, got, want)
+			}
+		}
+	}
+
+	// The int32 accumulator wraps rather than saturating.
+	n := 1 << 17
+	x, y := make([]int8, n+32), make([]int8, n+32)
+	for i := range x {
+		x[i], y[i] = math.MinInt8, math.MinInt8
+	}
+	want := int32(math.MinInt32) + 32*(1<<14)
+	if got := Dot8Unitary(x, y); got != want {
+		t.Errorf("unexpected Dot8Unitary result on overflow: got %d want %d", got, want)
+	}
+}
+
+func TestAxpyScal(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	const gd = 0x5a5a5a5a
+	for _, n := range kernelLens {
+		for _, inc := range []struct{ x, y uintptr }{{1, 1}, {2, 3}, {3, 1}} {
+			alpha := int32(rnd.Uint32())
+			prefix := fmt.Sprintf("n=%d incX=%d incY=%d alpha=%d", n, inc.x, inc.y, alpha)
+			x, y := randInt32s(rnd, n*int(inc.x)+1), randInt32s(rnd, n*int(inc.y)+2)
+			y[0], y[len(y)-1] = gd, gd
+
+			want := make([]int32, len(y))
+			copy(want, y)
+			for i := 0; i < n; i++ {
+				want[1+i*int(inc.y)] += alpha * x[i*int(inc.x)]
+			}
+			got := make([]int32, len(y))
+			copy(got, y)
+			AxpyInc(alpha, x, got[1:], uintptr(n), inc.x, inc.y, 0, 0)
+			checkInts(t, prefix+" AxpyInc", got, want)
+			if inc.x == 1 && inc.y == 1 {
+				copy(got, y)
+				AxpyUnitary(alpha, x[:n], got[1:n+1])
+				checkInts(t, prefix+" AxpyUnitary", got, want)
+			}
+
+			copy(want, y)
+			for i := 0; i < n; i++ {
+				want[1+i*int(inc.y)] *= alpha
+			}
+			copy(got, y)
+			ScalInc(alpha, got[1:], uintptr(n), inc.y)
+			checkInts(t, prefix+" ScalInc", got, want)
+			if inc.y == 1 {
+				copy(got, y)
+				ScalUnitary(alpha, got[1:n+1])
+				checkInts(t, prefix+" ScalUnitary", got, want)
+			}
+		}
+	}
+}
+
+func checkInts(t *testing.T, prefix string, got, want []int32) {
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("%s: unexpected value at %d: got %d want %d", prefix, i, got[i], want[i])
+		}
+	}
+}
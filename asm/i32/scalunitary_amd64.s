@@ -0,0 +1,36 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+#include "textflag.h"
+
+// PMULLD X7, X0
+#define PMULLD_X7_X0 BYTE $0x66; BYTE $0x0F; BYTE $0x38; BYTE $0x40; BYTE $0xC7
+// PMULLD X7, X1
+#define PMULLD_X7_X1 BYTE $0x66; BYTE $0x0F; BYTE $0x38; BYTE $0x40; BYTE $0xCF
+
+// func scalUnitarySSE4(alpha int32, x []int32)
+TEXT ·scalUnitarySSE4(SB), NOSPLIT, $0
+	MOVQ   x_base+8(FP), SI // SI = &x
+	MOVQ   x_len+16(FP), CX // CX = len(x)
+	SHRQ   $3, CX           // CX = len / 8
+	JZ     iscal_end        // if CX == 0 { return }
+	MOVL   alpha+0(FP), AX
+	MOVQ   AX, X7
+	PSHUFL $0, X7, X7       // X7 = { a, a, a, a }
+	XORQ   AX, AX           // i = 0
+
+iscal_loop: // Loop unrolled 8x   do {
+	MOVOU        (SI)(AX*4), X0 // X_i = x[i:i+4]
+	MOVOU        16(SI)(AX*4), X1
+	PMULLD_X7_X0                // X_i *= a
+	PMULLD_X7_X1
+	MOVOU        X0, (SI)(AX*4) // x[i:i+4] = X_i
+	MOVOU        X1, 16(SI)(AX*4)
+	ADDQ         $8, AX         // i += 8
+	LOOP         iscal_loop     // } while --CX > 0
+
+iscal_end:
+	RET
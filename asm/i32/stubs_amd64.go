@@ -0,0 +1,57 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+package i32
+
+// cpuid executes the CPUID instruction with the given EAX and ECX inputs.
+func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+
+// xgetbv returns the contents of the XCR0 extended control register.
+func xgetbv() (eax, edx uint32)
+
+// Sum is
+//  for _, v := range x {
+//  	sum += v
+//  }
+//  return
+func Sum(x []int32) (sum int32)
+
+// The SSE4.1 kernels below require len(x) to be a multiple of 8.
+
+// dotUnitarySSE4 is
+//  for i, v := range x {
+//  	sum += y[i] * v
+//  }
+//  return
+func dotUnitarySSE4(x, y []int32) (sum int32)
+
+// axpyUnitarySSE4 is
+//  for i, v := range x {
+//  	y[i] += alpha * v
+//  }
+func axpyUnitarySSE4(alpha int32, x, y []int32)
+
+// scalUnitarySSE4 is
+//  for i := range x {
+//  	x[i] *= alpha
+//  }
+func scalUnitarySSE4(alpha int32, x []int32)
+
+// dot8UnitarySSE4 is
+//  for i, v := range x {
+//  	sum += int32(y[i]) * int32(v)
+//  }
+//  return
+// It requires len(x) to be a multiple of 16.
+func dot8UnitarySSE4(x, y []int8) (sum int32)
+
+// dot8UnitaryAVX2 is
+//  for i, v := range x {
+//  	sum += int32(y[i]) * int32(v)
+//  }
+//  return
+// It requires len(x) to be a multiple of 32.
+func dot8UnitaryAVX2(x, y []int8) (sum int32)
@@ -0,0 +1,53 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !amd64 noasm appengine
+
+package i32
+
+// DotUnitary is
+//  for i, v := range x {
+//  	sum += y[i] * v
+//  }
+//  return
+func DotUnitary(x, y []int32) (sum int32) {
+	return dotUnitary(x, y)
+}
+
+// AxpyUnitary is
+//  for i, v := range x {
+//  	y[i] += alpha * v
+//  }
+func AxpyUnitary(alpha int32, x, y []int32) {
+	axpyUnitary(alpha, x, y)
+}
+
+// ScalUnitary is
+//  for i := range x {
+//  	x[i] *= alpha
+//  }
+func ScalUnitary(alpha int32, x []int32) {
+	scalUnitary(alpha, x)
+}
+
+// Dot8Unitary is
+//  for i, v := range x {
+//  	sum += int32(y[i]) * int32(v)
+//  }
+//  return
+func Dot8Unitary(x, y []int8) (sum int32) {
+	return dot8Unitary(x, y)
+}
+
+// Sum is
+//  for _, v := range x {
+//  	sum += v
+//  }
+//  return
+func Sum(x []int32) (sum int32) {
+	for _, v := range x {
+		sum += v
+	}
+	return
+}
@@ -0,0 +1,49 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine
+
+#include "textflag.h"
+
+// func Sum(x []int32) (sum int32)
+TEXT ·Sum(SB), NOSPLIT, $0
+	MOVQ x_base+0(FP), SI // SI = &x
+	MOVQ x_len+8(FP), CX  // CX = len(x)
+	XORQ AX, AX           // i = 0
+	XORQ DX, DX           // DX = 0, the scalar sum
+	PXOR X4, X4           // Clear accumulators
+	PXOR X5, X5
+	MOVQ CX, BX
+	ANDQ $7, BX           // BX = len % 8
+	SHRQ $3, CX           // CX = floor( len / 8 )
+	JZ   sum_tail_start   // if CX == 0 { goto sum_tail_start }
+
+sum_loop: // Loop unrolled 8x   do {
+	MOVOU (SI)(AX*4), X0 // X_i = x[i:i+4]
+	MOVOU 16(SI)(AX*4), X1
+	PADDL X0, X4         // X_(i+4) += X_i
+	PADDL X1, X5
+	ADDQ  $8, AX         // i += 8
+	LOOP  sum_loop       // } while --CX > 0
+	PADDL X5, X4         // Combine accumulators
+
+sum_tail_start:
+	CMPQ BX, $0 // if BX == 0 { goto sum_end }
+	JE   sum_end
+	MOVQ BX, CX
+
+sum_tail: // do {
+	ADDL (SI)(AX*4), DX // DX += x[i]
+	INCQ AX             // i++
+	LOOP sum_tail       // } while --CX > 0
+
+sum_end:
+	PSHUFL $0x4E, X4, X5 // X5 = { X4[1], X4[0], X4[3], X4[2] }
+	PADDL  X5, X4
+	PSHUFL $0xB1, X4, X5 // X5 = { X4[2], X4[3], X4[0], X4[1] }
+	PADDL  X5, X4        // X4[0] = sum(X4)
+	MOVQ   X4, R8
+	ADDL   R8, DX        // DX += X4[0]
+	MOVL   DX, sum+24(FP)
+	RET
@@ -0,0 +1,256 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// The asmbench command compares the assembly kernels with their pure Go
+// fallbacks. It runs BenchmarkKernels in each kernel package twice, once
+// as built and once with the noasm build tag, and writes one record for
+// each kernel, length and increment with the time per call of both
+// builds, the speedup of the assembly build and its GFLOP/s and GB/s.
+//
+// Run from the asm directory it compares all packages:
+//  go run ./internal/asmbench > kernels.csv
+// The -pkg flag takes a comma separated list of packages, -run selects
+// kernels with a regular expression matched against the benchmark name
+// following BenchmarkKernels/, -benchtime is passed to go test and
+// -json writes a JSON array instead of CSV.
+//
+// Kernels without an assembly implementation on the benchmarking
+// architecture run the same code in both builds, so their speedup is
+// only a measure of the noise in the timings.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var allPkgs = []string{"c64", "c128", "f32", "f64", "f16", "i32"}
+
+func main() {
+	pkgList := flag.String("pkg", strings.Join(allPkgs, ","), "comma separated list of packages to benchmark")
+	run := flag.String("run", ".", "regular expression selecting kernel benchmarks")
+	benchtime := flag.String("benchtime", "", "benchtime passed to go test")
+	asJSON := flag.Bool("json", false, "write JSON instead of CSV")
+	flag.Parse()
+
+	var rows []row
+	for _, p := range strings.Split(*pkgList, ",") {
+		asm, err := bench(p, "", *run, *benchtime)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "asmbench: %s: %v\n", p, err)
+			os.Exit(1)
+		}
+		noasm, err := bench(p, "noasm", *run, *benchtime)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "asmbench: %s with noasm: %v\n", p, err)
+			os.Exit(1)
+		}
+		rows = append(rows, compare(p, asm, noasm)...)
+	}
+
+	var err error
+	if *asJSON {
+		err = writeJSON(os.Stdout, rows)
+	} else {
+		err = writeCSV(os.Stdout, rows)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "asmbench: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// bench runs the kernel benchmarks of the package in the directory p with
+// the build tags and returns the parsed results.
+func bench(p, tags, run, benchtime string) ([]result, error) {
+	args := []string{"test", "-run", "^$", "-bench", "^BenchmarkKernels$/" + run}
+	if tags != "" {
+		args = append(args, "-tags", tags)
+	}
+	if benchtime != "" {
+		args = append(args, "-benchtime", benchtime)
+	}
+	args = append(args, "./"+p)
+	var out bytes.Buffer
+	cmd := exec.Command("go", args...)
+	cmd.Stdout = &out
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("go %s: %v\n%s", strings.Join(args, " "), err, out.Bytes())
+	}
+	return parse(&out)
+}
+
+// result is a single benchmark result.
+type result struct {
+	kernel string
+	n, inc int
+	nsOp   float64
+	gflops float64
+	gbs    float64
+}
+
+// parse returns the results of the BenchmarkKernels lines of go test
+// output read from r. Other lines are ignored.
+func parse(r io.Reader) ([]result, error) {
+	var results []result
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		res, ok, err := parseLine(sc.Text())
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			results = append(results, res)
+		}
+	}
+	return results, sc.Err()
+}
+
+// parseLine parses a line of go test output of the form
+//  BenchmarkKernels/<kernel>/n=<n>/inc=<inc>-<procs> <iters> <v> ns/op [<v> <unit>]...
+// It returns false if the line is not a BenchmarkKernels result.
+func parseLine(line string) (res result, ok bool, err error) {
+	const prefix = "BenchmarkKernels/"
+	f := strings.Fields(line)
+	if len(f) < 4 || !strings.HasPrefix(f[0], prefix) || len(f)%2 != 0 {
+		return result{}, false, nil
+	}
+	name := f[0][len(prefix):]
+	if i := strings.LastIndex(name, "-"); i >= 0 && !strings.HasSuffix(name[:i], "inc=") {
+		if _, err := strconv.Atoi(name[i+1:]); err == nil {
+			name = name[:i]
+		}
+	}
+	parts := strings.Split(name, "/")
+	if len(parts) != 3 || !strings.HasPrefix(parts[1], "n=") || !strings.HasPrefix(parts[2], "inc=") {
+		return result{}, false, fmt.Errorf("malformed benchmark name %q", f[0])
+	}
+	res.kernel = parts[0]
+	res.n, err = strconv.Atoi(parts[1][len("n="):])
+	if err != nil {
+		return result{}, false, fmt.Errorf("malformed length in %q: %v", f[0], err)
+	}
+	res.inc, err = strconv.Atoi(parts[2][len("inc="):])
+	if err != nil {
+		return result{}, false, fmt.Errorf("malformed increment in %q: %v", f[0], err)
+	}
+	for i := 2; i < len(f); i += 2 {
+		v, err := strconv.ParseFloat(f[i], 64)
+		if err != nil {
+			return result{}, false, fmt.Errorf("malformed value in %q: %v", line, err)
+		}
+		switch f[i+1] {
+		case "ns/op":
+			res.nsOp = v
+		case "GFLOP/s":
+			res.gflops = v
+		case "GB/s":
+			res.gbs = v
+		}
+	}
+	return res, true, nil
+}
+
+// row is a record of the comparison of the assembly and noasm builds.
+type row struct {
+	Package string  `json:"package"`
+	Kernel  string  `json:"kernel"`
+	N       int     `json:"n"`
+	Inc     int     `json:"inc"`
+	AsmNs   float64 `json:"asm_ns_op"`
+	NoasmNs float64 `json:"noasm_ns_op"`
+	Speedup float64 `json:"speedup"`
+	GFLOPs  float64 `json:"asm_gflops"`
+	GBs     float64 `json:"asm_gbs"`
+}
+
+// compare joins the results of the assembly and noasm builds of package
+// p. Results present in only one build are dropped.
+func compare(p string, asm, noasm []result) []row {
+	type key struct {
+		kernel string
+		n, inc int
+	}
+	ref := make(map[key]result)
+	for _, r := range noasm {
+		ref[key{r.kernel, r.n, r.inc}] = r
+	}
+	var rows []row
+	for _, r := range asm {
+		g, ok := ref[key{r.kernel, r.n, r.inc}]
+		if !ok {
+			continue
+		}
+		var speedup float64
+		if r.nsOp != 0 {
+			speedup = g.nsOp / r.nsOp
+		}
+		rows = append(rows, row{
+			Package: p,
+			Kernel:  r.kernel,
+			N:       r.n,
+			Inc:     r.inc,
+			AsmNs:   r.nsOp,
+			NoasmNs: g.nsOp,
+			Speedup: speedup,
+			GFLOPs:  r.gflops,
+			GBs:     r.gbs,
+		})
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		a, b := rows[i], rows[j]
+		if a.Kernel != b.Kernel {
+			return a.Kernel < b.Kernel
+		}
+		if a.Inc != b.Inc {
+			return a.Inc < b.Inc
+		}
+		return a.N < b.N
+	})
+	return rows
+}
+
+func writeJSON(w io.Writer, rows []row) error {
+	if rows == nil {
+		rows = []row{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "\t")
+	return enc.Encode(rows)
+}
+
+func writeCSV(w io.Writer, rows []row) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"package", "kernel", "n", "inc", "asm_ns_op", "noasm_ns_op", "speedup", "asm_gflops", "asm_gbs"})
+	for _, r := range rows {
+		cw.Write([]string{
+			r.Package,
+			r.Kernel,
+			strconv.Itoa(r.N),
+			strconv.Itoa(r.Inc),
+			formatFloat(r.AsmNs),
+			formatFloat(r.NoasmNs),
+			formatFloat(r.Speedup),
+			formatFloat(r.GFLOPs),
+			formatFloat(r.GBs),
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', 4, 64)
+}
@@ -0,0 +1,84 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestParseLine(t *testing.T) {
+	for _, test := range []struct {
+		line string
+		want result
+		ok   bool
+		err  bool
+	}{
+		{
+			line: "BenchmarkKernels/AxpyInc/n=100/inc=-2-8   \t 1000\t  141.0 ns/op\t 17.08 GB/s\t 5.693 GFLOP/s",
+			want: result{kernel: "AxpyInc", n: 100, inc: -2, nsOp: 141, gflops: 5.693, gbs: 17.08},
+			ok:   true,
+		},
+		{
+			// Without a GOMAXPROCS suffix the sign of a negative
+			// increment must not be taken for one.
+			line: "BenchmarkKernels/AxpyInc/n=1/inc=-2 1000 18.15 ns/op 1.359 GB/s 0.4530 GFLOP/s",
+			want: result{kernel: "AxpyInc", n: 1, inc: -2, nsOp: 18.15, gflops: 0.453, gbs: 1.359},
+			ok:   true,
+		},
+		{
+			line: "BenchmarkKernels/Float32ToFloat16/n=10/inc=1-4 5000000 30.5 ns/op 1.967 GB/s",
+			want: result{kernel: "Float32ToFloat16", n: 10, inc: 1, nsOp: 30.5, gbs: 1.967},
+			ok:   true,
+		},
+		{line: "goos: linux"},
+		{line: "BenchmarkDaxpy/n=10-8 1000 20 ns/op"},
+		{line: "ok  \tgithub.com/gonum/internal/asm/f64\t1.2s"},
+		{line: "BenchmarkKernels/AxpyInc/m=1/inc=1 1000 18 ns/op", err: true},
+		{line: "BenchmarkKernels/AxpyInc/n=1/inc=1 1000 fast ns/op", err: true},
+	} {
+		got, ok, err := parseLine(test.line)
+		if (err != nil) != test.err {
+			t.Errorf("unexpected error for %q: %v", test.line, err)
+			continue
+		}
+		if ok != test.ok {
+			t.Errorf("unexpected ok for %q: got %t want %t", test.line, ok, test.ok)
+		}
+		if got != test.want {
+			t.Errorf("unexpected result for %q: got %+v want %+v", test.line, got, test.want)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	asm := []result{
+		{kernel: "ScalInc", n: 10, inc: 2, nsOp: 10, gflops: 1, gbs: 16},
+		{kernel: "AxpyUnitary", n: 100, inc: 1, nsOp: 20, gflops: 10, gbs: 120},
+		{kernel: "AxpyUnitary", n: 10, inc: 1, nsOp: 5, gflops: 4, gbs: 48},
+		{kernel: "DotUnitary", n: 10, inc: 1, nsOp: 5},
+	}
+	noasm := []result{
+		{kernel: "AxpyUnitary", n: 10, inc: 1, nsOp: 10},
+		{kernel: "AxpyUnitary", n: 100, inc: 1, nsOp: 80},
+		{kernel: "ScalInc", n: 10, inc: 2, nsOp: 10},
+	}
+	rows := compare("f64", asm, noasm)
+	var buf bytes.Buffer
+	if err := writeCSV(&buf, rows); err != nil {
+		t.Fatalf("unexpected error writing CSV: %v", err)
+	}
+	want := strings.Join([]string{
+		"package,kernel,n,inc,asm_ns_op,noasm_ns_op,speedup,asm_gflops,asm_gbs",
+		"f64,AxpyUnitary,10,1,5,10,2,4,48",
+		"f64,AxpyUnitary,100,1,20,80,4,10,120",
+		"f64,ScalInc,10,2,10,10,1,1,16",
+		"",
+	}, "\n")
+	if got := buf.String(); got != want {
+		t.Errorf("unexpected CSV:\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -0,0 +1,451 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// The asmgen command renders the tests, fuzz targets, page boundary and
+// accuracy tests and benchmarks of the Axpy, Dot and Scal kernels that
+// are shared by the c64, c128, f32 and f64 packages from the single
+// template set in templates.go. The kernels themselves, and the tests
+// and benchmarks of the other kernels, are written by hand.
+//
+// Run from the asm directory it regenerates all four packages:
+//  go run ./internal/asmgen
+// With -pkg it regenerates the named package in the current directory,
+// which is how the go:generate directives in each package invoke it.
+// A file in a package directory that carries the asmgen header but is
+// no longer generated is removed. With -check no files are written or
+// removed; asmgen lists any generated file that is missing or differs
+// from its rendering, and any such file that it no longer generates,
+// and exits with a non-zero status if there is one.
+//
+// The output is not passed through gofmt, since the gofmt doc comment
+// and line length rules differ between Go releases; the templates are
+// written in gofmt style instead.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// pkg describes one element package.
+type pkg struct {
+	Name    string // Name is the package name.
+	Prefix  string // Prefix is the element prefix used in benchmark names.
+	Type    string // Type is the element type.
+	Complex bool   // Complex is whether Type is a complex type.
+	Size    int    // Size is the size of Type in bytes.
+	Conj    string // Conj is the conjugation function for complex types.
+
+	// One and Two are the literals used for alpha in the benchmarks.
+	One, Two string
+	// Index converts the int n to a benchmark vector value.
+	Index string
+
+	// Asm lists the kernels that are implemented in assembly
+	// on amd64.
+	Asm map[string]bool
+	// ScalTest is whether scal_test.go is generated.
+	ScalTest bool
+}
+
+var pkgs = []pkg{
+	{
+		Name:    "c64",
+		Prefix:  "C64",
+		Type:    "complex64",
+		Size:    8,
+		Complex: true,
+		Conj:    "conj",
+		One:     "1+1i",
+		Two:     "complex64(2 + 2i)",
+		Index:   "complex(float32(n), float32(n))",
+		Asm: set(
+			"AxpyUnitary", "AxpyUnitaryTo", "AxpyInc", "AxpyIncTo",
+			"DotuUnitary", "DotcUnitary",
+		),
+		ScalTest: true,
+	},
+	{
+		Name:    "c128",
+		Prefix:  "C128",
+		Type:    "complex128",
+		Size:    16,
+		Complex: true,
+		Conj:    "cmplx.Conj",
+		One:     "1+1i",
+		Two:     "complex128(2 + 2i)",
+		Index:   "complex(float64(n), float64(n))",
+		Asm: set(
+			"AxpyUnitary", "AxpyUnitaryTo", "AxpyInc", "AxpyIncTo",
+			"DotuUnitary", "DotcUnitary",
+		),
+		ScalTest: true,
+	},
+	{
+		Name:   "f32",
+		Prefix: "F32",
+		Type:   "float32",
+		Size:   4,
+		One:    "1",
+		Two:    "float32(2)",
+		Index:  "float32(n)",
+		Asm: set(
+			"AxpyUnitary", "AxpyUnitaryTo", "AxpyInc", "AxpyIncTo",
+		),
+		ScalTest: true,
+	},
+	{
+		Name:   "f64",
+		Prefix: "F64",
+		Type:   "float64",
+		Size:   8,
+		One:    "1",
+		Two:    "float64(2)",
+		Index:  "float64(n)",
+		Asm: set(
+			"AxpyUnitary", "AxpyUnitaryTo", "AxpyInc", "AxpyIncTo",
+			"ScalUnitary", "ScalUnitaryTo", "ScalInc", "ScalIncTo",
+			"DotUnitary", "DotInc",
+		),
+	},
+}
+
+func set(names ...string) map[string]bool {
+	m := make(map[string]bool)
+	for _, n := range names {
+		m[n] = true
+	}
+	return m
+}
+
+func main() {
+	name := flag.String("pkg", "", "generate only the named package into the current directory")
+	check := flag.Bool("check", false, "report stale generated files instead of writing them")
+	flag.Parse()
+
+	var (
+		stale, orphans []string
+		found          bool
+	)
+	for _, p := range pkgs {
+		dir := p.Name
+		if *name != "" {
+			if p.Name != *name {
+				continue
+			}
+			dir = "."
+		}
+		found = true
+		files, err := render(p)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "asmgen: %s: %v\n", p.Name, err)
+			os.Exit(1)
+		}
+		s, o, err := emit(dir, files, *check)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "asmgen: %v\n", err)
+			os.Exit(1)
+		}
+		stale = append(stale, s...)
+		orphans = append(orphans, o...)
+	}
+	if !found {
+		fmt.Fprintf(os.Stderr, "asmgen: unknown package %q\n", *name)
+		os.Exit(2)
+	}
+	for _, f := range stale {
+		fmt.Fprintf(os.Stderr, "asmgen: %s is out of date\n", f)
+	}
+	for _, f := range orphans {
+		fmt.Fprintf(os.Stderr, "asmgen: %s is no longer generated\n", f)
+	}
+	if len(stale) != 0 || len(orphans) != 0 {
+		os.Exit(1)
+	}
+}
+
+// emit writes files into dir and removes the other generated files in
+// dir, or if check is true returns the paths of the files in dir that
+// do not match and of the other generated files in dir.
+func emit(dir string, files map[string][]byte, check bool) (stale, orphans []string, err error) {
+	names := make([]string, 0, len(files))
+	for n := range files {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		path := filepath.Join(dir, n)
+		if !check {
+			err = ioutil.WriteFile(path, files[n], 0644)
+			if err != nil {
+				return nil, nil, err
+			}
+			continue
+		}
+		b, err := ioutil.ReadFile(path)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, nil, err
+		}
+		if !bytes.Equal(b, files[n]) {
+			stale = append(stale, path)
+		}
+	}
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, path := range paths {
+		if _, ok := files[filepath.Base(path)]; ok {
+			continue
+		}
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !isGenerated(b) {
+			continue
+		}
+		if check {
+			orphans = append(orphans, path)
+			continue
+		}
+		err = os.Remove(path)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return stale, orphans, nil
+}
+
+// isGenerated returns whether the Go source src carries the asmgen
+// header.
+func isGenerated(src []byte) bool {
+	for _, l := range bytes.Split(src, []byte("\n")) {
+		if string(l) == generated {
+			return true
+		}
+		if bytes.HasPrefix(l, []byte("package ")) {
+			break
+		}
+	}
+	return false
+}
+
+// render returns the contents of the generated files for p keyed
+// by file name.
+func render(p pkg) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	fuzz, err := execute("fuzz", fuzzTemplate, p)
+	if err != nil {
+		return nil, err
+	}
+	page, err := execute("page", pageTemplate, p)
+	if err != nil {
+		return nil, err
+	}
+	accuracy, err := execute("accuracy", accuracyTemplate, p)
+	if err != nil {
+		return nil, err
+	}
+	kernelBench, err := execute("kernelbench", kernelBenchTemplate, p)
+	if err != nil {
+		return nil, err
+	}
+	for _, fam := range families {
+		for _, k := range fam.kernels {
+			if !k.in(p) {
+				continue
+			}
+			if k.page != "" {
+				call, err := execute(k.name+".page", k.page, p)
+				if err != nil {
+					return nil, err
+				}
+				page += pageTest(k.name, k.incs, call)
+			}
+			if k.accuracy != "" {
+				call, err := execute(k.name+".accuracy", k.accuracy, p)
+				if err != nil {
+					return nil, err
+				}
+				accuracy += accuracyTest(k.name, k.incs, k.acc, call)
+			}
+			if k.bench != "" {
+				cost, err := execute(k.name+".cost", k.cost, p)
+				if err != nil {
+					return nil, err
+				}
+				call, err := execute(k.name+".bench", k.bench, p)
+				if err != nil {
+					return nil, err
+				}
+				kernelBench += benchKernel(k.name, k.incs, cost, call)
+			}
+			if p.Asm[k.name] && k.fuzz != "" {
+				f, err := execute(k.name+".fuzz", k.fuzz, p)
+				if err != nil {
+					return nil, err
+				}
+				fuzz += "\n" + f
+			}
+		}
+	}
+
+	files["fuzz_amd64_test.go"] = []byte(fuzz)
+	files["page_linux_test.go"] = []byte(page + "}\n")
+	files["accuracy_test.go"] = []byte(accuracy + "}\n")
+	files["kernelbench_test.go"] = []byte(kernelBench + "}\n")
+	b, err := execute("bench", benchTemplate, p)
+	if err != nil {
+		return nil, err
+	}
+	files["bench_test.go"] = []byte(b)
+	if p.ScalTest {
+		b, err := execute("scaltest", scalTestTemplate, p)
+		if err != nil {
+			return nil, err
+		}
+		files["scal_test.go"] = []byte(b)
+	}
+
+	fset := token.NewFileSet()
+	for n, b := range files {
+		_, err := parser.ParseFile(fset, n, b, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// pageTest returns the pageTest literal checking the kernel name with
+// the asmtest increments incs by executing call.
+func pageTest(name, incs, call string) string {
+	inc := "inc"
+	if incs == "PageUnitaryIncs" {
+		inc = "_"
+	}
+	return fmt.Sprintf("\t{name: %q, incs: asmtest.%s, kernel: func(p *asmtest.Pages, n, %s int) {\n%s\t}},\n",
+		name, incs, inc, indent("\t\t", call))
+}
+
+// accuracyTest returns the accuracyTest literal checking the kernel name
+// under the bound acc by executing call. The increments are the accuracy
+// counterparts of the page increments incs.
+func accuracyTest(name, incs, acc, call string) string {
+	incs = strings.Replace(incs, "Page", "Accuracy", 1)
+	inc := "inc"
+	if incs == "AccuracyUnitaryIncs" {
+		inc = "_"
+	}
+	return fmt.Sprintf("\t{name: %q, incs: asmtest.%s, acc: %s, kernel: func(rnd *rand.Rand, n, %s int, acc asmtest.Accuracy) error {\n%s\t}},\n",
+		name, incs, acc, inc, indent("\t\t", call))
+}
+
+// benchKernel returns the benchKernel literal benchmarking the kernel
+// name with cost by executing call. The increments are the benchmark
+// counterparts of the page increments incs.
+func benchKernel(name, incs, cost, call string) string {
+	incs = strings.Replace(incs, "Page", "Bench", 1)
+	inc := "inc"
+	if incs == "BenchUnitaryIncs" {
+		inc = "_"
+	}
+	return fmt.Sprintf("\t{name: %q, incs: asmtest.%s, cost: %s, setup: func(n, %s int) func() {\n%s\t}},\n",
+		name, incs, cost, inc, indent("\t\t", call))
+}
+
+func indent(prefix, s string) string {
+	lines := strings.SplitAfter(s, "\n")
+	for i, l := range lines {
+		if l != "" {
+			lines[i] = prefix + l
+		}
+	}
+	return strings.Join(lines, "")
+}
+
+var funcs = template.FuncMap{
+	"unitary": unitaryBenchmarks,
+	"inc":     incBenchmarks,
+}
+
+func execute(name, text string, p pkg) (string, error) {
+	t, err := template.New(name).Funcs(funcs).Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	err = t.Execute(&buf, p)
+	return buf.String(), err
+}
+
+// unitaryBenchmarks returns the benchmarks named BenchmarkName<n>
+// that call helper with each unitary benchmark length and f.
+func unitaryBenchmarks(name, helper, f string) string {
+	var lines [][2]string
+	for _, n := range []int{1, 2, 3, 4, 5, 10, 100, 1000, 5000, 10000, 50000} {
+		lines = append(lines, [2]string{
+			fmt.Sprintf("func Benchmark%s%d(t *testing.B)", name, n),
+			fmt.Sprintf("%s(t, %d, %s)", helper, n, f),
+		})
+	}
+	return align(lines)
+}
+
+// incBenchmarks returns the benchmarks named BenchmarkName<N><Inc>
+// that call helper with each strided benchmark length and increment
+// and f.
+func incBenchmarks(name, helper, f string) string {
+	bench := func(n, inc int) [2]string {
+		id := fmt.Sprintf("Inc%d", inc)
+		if inc < 0 {
+			id = fmt.Sprintf("IncM%d", -inc)
+		}
+		return [2]string{
+			fmt.Sprintf("func Benchmark%sN%d%s(b *testing.B)", name, n, id),
+			fmt.Sprintf("%s(b, %d, %d, %s)", helper, n, inc, f),
+		}
+	}
+	blocks := []string{align([][2]string{bench(1, 1)})}
+	for _, n := range []int{2, 3, 4, 10, 1000, 100000} {
+		var lines [][2]string
+		for _, inc := range []int{1, 2, 4, 10} {
+			lines = append(lines, bench(n, inc))
+		}
+		blocks = append(blocks, align(lines))
+	}
+	var lines [][2]string
+	for _, inc := range []int{-1, -2, -4, -10} {
+		lines = append(lines, bench(100000, inc))
+	}
+	blocks = append(blocks, align(lines))
+	return strings.Join(blocks, "\n")
+}
+
+// align returns the function declarations in lines with the calls as
+// their one line bodies, aligned as gofmt does.
+func align(lines [][2]string) string {
+	var width int
+	for _, l := range lines {
+		if len(l[0]) > width {
+			width = len(l[0])
+		}
+	}
+	var buf bytes.Buffer
+	for _, l := range lines {
+		fmt.Fprintf(&buf, "%-*s { %s }\n", width, l[0], l[1])
+	}
+	return buf.String()
+}
@@ -0,0 +1,29 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestGenerated(t *testing.T) {
+	for _, p := range pkgs {
+		files, err := render(p)
+		if err != nil {
+			t.Fatalf("unexpected error rendering %s: %v", p.Name, err)
+		}
+		stale, orphans, err := emit(filepath.Join("..", "..", p.Name), files, true)
+		if err != nil {
+			t.Fatalf("unexpected error checking %s: %v", p.Name, err)
+		}
+		for _, f := range stale {
+			t.Errorf("%s is out of date: run go generate", f)
+		}
+		for _, f := range orphans {
+			t.Errorf("%s is no longer generated: run go generate", f)
+		}
+	}
+}
@@ -0,0 +1,1346 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+// generated marks the files written by asmgen.
+const generated = "// Code generated by asmgen from asm/internal/asmgen/templates.go; DO NOT EDIT."
+
+// header starts every generated file.
+const header = `// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+` + generated + `
+
+`
+
+// family is a set of related kernels.
+type family struct {
+	name    string
+	kernels []kernel
+}
+
+// kernel holds the templates of the tests and benchmarks of one
+// kernel, which are executed with a pkg. The fuzz target is generated
+// for packages where the kernel is implemented in assembly. The page
+// test body calls the kernel with vectors from the *asmtest.Pages p for
+// the length n and increment inc, where incs names the asmtest
+// increments it is checked with.
+type kernel struct {
+	name string
+	only string // only is "real" or "complex" if the kernel is limited to those types.
+	fuzz string
+	incs string
+	page string
+
+	// The accuracy test body returns the error from comparing the
+	// kernel's result for random vectors from rnd of length n and
+	// increment inc with its exact result under the bound acc, the
+	// literal of the kernel's documented asmtest.Accuracy.
+	acc      string
+	accuracy string
+
+	// The bench body allocates the vectors of length n and increment
+	// inc for a benchmark of the kernel and returns a function making
+	// one call, and cost is the literal of the kernel's asmtest.Cost.
+	cost  string
+	bench string
+}
+
+// in returns whether k is generated for p.
+func (k kernel) in(p pkg) bool {
+	switch k.only {
+	case "real":
+		return !p.Complex
+	case "complex":
+		return p.Complex
+	}
+	return true
+}
+
+var families = []family{
+	{name: "axpy", kernels: []kernel{
+		{
+			name: "AxpyUnitary",
+			fuzz: `func FuzzAxpyUnitary(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, _, _, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		alpha := fuzzValue[{{.Type}}](s)
+		x := newFuzzVector[{{.Type}}](s, int(n), 1, fuzzFront(off, 0))
+		y := newFuzzVector[{{.Type}}](s, int(n), 1, fuzzFront(off, 1))
+		xr, yr := x.Clone(), y.Clone()
+
+		AxpyUnitary(alpha, x.Slice(), y.Slice())
+
+		yv := yr.Slice()
+		for i, v := range xr.Slice() {
+			yv[i] += fuzzMul(alpha, v)
+		}
+		asmtest.CheckVector(t, "x", x, xr)
+		asmtest.CheckVector(t, "y", y, yr)
+	})
+}
+`,
+			incs: "PageUnitaryIncs",
+			page: `x := asmtest.PageVector[{{.Type}}](p, n, 1)
+y := asmtest.PageVector[{{.Type}}](p, n, 1)
+AxpyUnitary(1, x, y)
+`,
+			cost: "asmtest.Cost{Flops: {{if .Complex}}8{{else}}2{{end}}, Bytes: 3 * {{.Size}}}",
+			bench: `x := asmtest.BenchVector[{{.Type}}](n, 1)
+y := asmtest.BenchVector[{{.Type}}](n, 1)
+return func() {
+	AxpyUnitary(1, x, y)
+}
+`,
+		},
+		{
+			name: "AxpyUnitaryTo",
+			fuzz: `func FuzzAxpyUnitaryTo(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, _, _, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		alpha := fuzzValue[{{.Type}}](s)
+		x := newFuzzVector[{{.Type}}](s, int(n), 1, fuzzFront(off, 0))
+		y := newFuzzVector[{{.Type}}](s, int(n), 1, fuzzFront(off, 1))
+		dst := newFuzzVector[{{.Type}}](s, int(n), 1, fuzzFront(off, 2))
+		xr, yr, dstr := x.Clone(), y.Clone(), dst.Clone()
+
+		AxpyUnitaryTo(dst.Slice(), alpha, x.Slice(), y.Slice())
+
+		yv, dv := yr.Slice(), dstr.Slice()
+		for i, v := range xr.Slice() {
+			dv[i] = fuzzMul(alpha, v) + yv[i]
+		}
+		asmtest.CheckVector(t, "x", x, xr)
+		asmtest.CheckVector(t, "y", y, yr)
+		asmtest.CheckVector(t, "dst", dst, dstr)
+	})
+}
+`,
+			incs: "PageUnitaryIncs",
+			page: `x := asmtest.PageVector[{{.Type}}](p, n, 1)
+y := asmtest.PageVector[{{.Type}}](p, n, 1)
+dst := asmtest.PageVector[{{.Type}}](p, n, 1)
+AxpyUnitaryTo(dst, 1, x, y)
+`,
+			cost: "asmtest.Cost{Flops: {{if .Complex}}8{{else}}2{{end}}, Bytes: 3 * {{.Size}}}",
+			bench: `x := asmtest.BenchVector[{{.Type}}](n, 1)
+y := asmtest.BenchVector[{{.Type}}](n, 1)
+dst := asmtest.BenchVector[{{.Type}}](n, 1)
+return func() {
+	AxpyUnitaryTo(dst, 1, x, y)
+}
+`,
+		},
+		{
+			name: "AxpyInc",
+			fuzz: `func FuzzAxpyInc(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, incX, incY, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		alpha := fuzzValue[{{.Type}}](s)
+		ln, incx, incy := int(n), fuzzInc(incX), fuzzInc(incY)
+		x := newFuzzVector[{{.Type}}](s, ln, incx, fuzzFront(off, 0))
+		y := newFuzzVector[{{.Type}}](s, ln, incy, fuzzFront(off, 1))
+		xr, yr := x.Clone(), y.Clone()
+		ix, iy := fuzzStart(ln, incx), fuzzStart(ln, incy)
+
+		AxpyInc(alpha, x.Slice(), y.Slice(), uintptr(ln), uintptr(incx), uintptr(incy), uintptr(ix), uintptr(iy))
+
+		xv, yv := xr.Slice(), yr.Slice()
+		for i := 0; i < ln; i++ {
+			yv[iy] += fuzzMul(alpha, xv[ix])
+			ix += incx
+			iy += incy
+		}
+		asmtest.CheckVector(t, "x", x, xr)
+		asmtest.CheckVector(t, "y", y, yr)
+	})
+}
+`,
+			incs: "PageIncs",
+			page: `x := asmtest.PageVector[{{.Type}}](p, n, inc)
+y := asmtest.PageVector[{{.Type}}](p, n, -inc)
+AxpyInc(1, x, y, uintptr(n), uintptr(inc), uintptr(-inc), uintptr(asmtest.Start(n, inc)), uintptr(asmtest.Start(n, -inc)))
+`,
+			cost: "asmtest.Cost{Flops: {{if .Complex}}8{{else}}2{{end}}, Bytes: 3 * {{.Size}}}",
+			bench: `x := asmtest.BenchVector[{{.Type}}](n, inc)
+y := asmtest.BenchVector[{{.Type}}](n, -inc)
+return func() {
+	AxpyInc(1, x, y, uintptr(n), uintptr(inc), uintptr(-inc), uintptr(asmtest.Start(n, inc)), uintptr(asmtest.Start(n, -inc)))
+}
+`,
+		},
+		{
+			name: "AxpyIncTo",
+			fuzz: `func FuzzAxpyIncTo(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, incX, incY, incDst int8, off uint8) {
+		s := &fuzzSource{data: data}
+		alpha := fuzzValue[{{.Type}}](s)
+		ln, incx, incy, incd := int(n), fuzzInc(incX), fuzzInc(incY), fuzzInc(incDst)
+		x := newFuzzVector[{{.Type}}](s, ln, incx, fuzzFront(off, 0))
+		y := newFuzzVector[{{.Type}}](s, ln, incy, fuzzFront(off, 1))
+		dst := newFuzzVector[{{.Type}}](s, ln, incd, fuzzFront(off, 2))
+		xr, yr, dstr := x.Clone(), y.Clone(), dst.Clone()
+		ix, iy, idst := fuzzStart(ln, incx), fuzzStart(ln, incy), fuzzStart(ln, incd)
+
+		AxpyIncTo(dst.Slice(), uintptr(incd), uintptr(idst), alpha, x.Slice(), y.Slice(), uintptr(ln), uintptr(incx), uintptr(incy), uintptr(ix), uintptr(iy))
+
+		xv, yv, dv := xr.Slice(), yr.Slice(), dstr.Slice()
+		for i := 0; i < ln; i++ {
+			dv[idst] = fuzzMul(alpha, xv[ix]) + yv[iy]
+			ix += incx
+			iy += incy
+			idst += incd
+		}
+		asmtest.CheckVector(t, "x", x, xr)
+		asmtest.CheckVector(t, "y", y, yr)
+		asmtest.CheckVector(t, "dst", dst, dstr)
+	})
+}
+`,
+			incs: "PageIncs",
+			page: `x := asmtest.PageVector[{{.Type}}](p, n, inc)
+y := asmtest.PageVector[{{.Type}}](p, n, -inc)
+dst := asmtest.PageVector[{{.Type}}](p, n, inc)
+AxpyIncTo(dst, uintptr(inc), uintptr(asmtest.Start(n, inc)), 1, x, y, uintptr(n), uintptr(inc), uintptr(-inc), uintptr(asmtest.Start(n, inc)), uintptr(asmtest.Start(n, -inc)))
+`,
+			cost: "asmtest.Cost{Flops: {{if .Complex}}8{{else}}2{{end}}, Bytes: 3 * {{.Size}}}",
+			bench: `x := asmtest.BenchVector[{{.Type}}](n, inc)
+y := asmtest.BenchVector[{{.Type}}](n, -inc)
+dst := asmtest.BenchVector[{{.Type}}](n, inc)
+return func() {
+	AxpyIncTo(dst, uintptr(inc), uintptr(asmtest.Start(n, inc)), 1, x, y, uintptr(n), uintptr(inc), uintptr(-inc), uintptr(asmtest.Start(n, inc)), uintptr(asmtest.Start(n, -inc)))
+}
+`,
+		},
+	}},
+	{name: "dot", kernels: []kernel{
+		{
+			name: "DotUnitary",
+			only: "real",
+			fuzz: `func FuzzDotUnitary(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, _, _, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		x := newFuzzVector[{{.Type}}](s, int(n), 1, fuzzFront(off, 0))
+		y := newFuzzVector[{{.Type}}](s, int(n), 1, fuzzFront(off, 1))
+		xr, yr := x.Clone(), y.Clone()
+
+		got := DotUnitary(x.Slice(), y.Slice())
+
+		var (
+			want  {{.Type}}
+			terms fuzzTerms
+		)
+		yv := yr.Slice()
+		for i, v := range xr.Slice() {
+			want += fuzzMul(yv[i], v)
+			addFuzzTerms(&terms, yv[i], v)
+		}
+		checkFuzzSum(t, "DotUnitary", got, want, terms)
+		asmtest.CheckVector(t, "x", x, xr)
+		asmtest.CheckVector(t, "y", y, yr)
+	})
+}
+`,
+			incs: "PageUnitaryIncs",
+			page: `x := asmtest.PageVector[{{.Type}}](p, n, 1)
+y := asmtest.PageVector[{{.Type}}](p, n, 1)
+DotUnitary(x, y)
+`,
+			acc: "asmtest.Accuracy{Cond: 1}",
+			accuracy: `x := asmtest.AccuracyVector[{{.Type}}](rnd, n, 1)
+y := asmtest.AccuracyVector[{{.Type}}](rnd, n, 1)
+return asmtest.CompareExact(DotUnitary(x, y), asmtest.ExactDot(x, y), acc)
+`,
+			cost: "asmtest.Cost{Flops: {{if .Complex}}8{{else}}2{{end}}, Bytes: 2 * {{.Size}}}",
+			bench: `x := asmtest.BenchVector[{{.Type}}](n, 1)
+y := asmtest.BenchVector[{{.Type}}](n, 1)
+return func() {
+	kernelSink = DotUnitary(x, y)
+}
+`,
+		},
+		{
+			name: "DotInc",
+			only: "real",
+			fuzz: `func FuzzDotInc(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, incX, incY, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		ln, incx, incy := int(n), fuzzInc(incX), fuzzInc(incY)
+		x := newFuzzVector[{{.Type}}](s, ln, incx, fuzzFront(off, 0))
+		y := newFuzzVector[{{.Type}}](s, ln, incy, fuzzFront(off, 1))
+		xr, yr := x.Clone(), y.Clone()
+		ix, iy := fuzzStart(ln, incx), fuzzStart(ln, incy)
+
+		got := DotInc(x.Slice(), y.Slice(), uintptr(ln), uintptr(incx), uintptr(incy), uintptr(ix), uintptr(iy))
+
+		var (
+			want  {{.Type}}
+			terms fuzzTerms
+		)
+		xv, yv := xr.Slice(), yr.Slice()
+		for i := 0; i < ln; i++ {
+			want += fuzzMul(yv[iy], xv[ix])
+			addFuzzTerms(&terms, yv[iy], xv[ix])
+			ix += incx
+			iy += incy
+		}
+		checkFuzzSum(t, "DotInc", got, want, terms)
+		asmtest.CheckVector(t, "x", x, xr)
+		asmtest.CheckVector(t, "y", y, yr)
+	})
+}
+`,
+			incs: "PageIncs",
+			page: `x := asmtest.PageVector[{{.Type}}](p, n, inc)
+y := asmtest.PageVector[{{.Type}}](p, n, -inc)
+DotInc(x, y, uintptr(n), uintptr(inc), uintptr(-inc), uintptr(asmtest.Start(n, inc)), uintptr(asmtest.Start(n, -inc)))
+`,
+			acc: "asmtest.Accuracy{Cond: 1}",
+			accuracy: `x := asmtest.AccuracyVector[{{.Type}}](rnd, n, inc)
+y := asmtest.AccuracyVector[{{.Type}}](rnd, n, -inc)
+got := DotInc(x, y, uintptr(n), uintptr(inc), uintptr(-inc), uintptr(asmtest.Start(n, inc)), uintptr(asmtest.Start(n, -inc)))
+return asmtest.CompareExact(got, asmtest.ExactDot(asmtest.Elems(x, n, inc), asmtest.Elems(y, n, -inc)), acc)
+`,
+			cost: "asmtest.Cost{Flops: {{if .Complex}}8{{else}}2{{end}}, Bytes: 2 * {{.Size}}}",
+			bench: `x := asmtest.BenchVector[{{.Type}}](n, inc)
+y := asmtest.BenchVector[{{.Type}}](n, -inc)
+return func() {
+	kernelSink = DotInc(x, y, uintptr(n), uintptr(inc), uintptr(-inc), uintptr(asmtest.Start(n, inc)), uintptr(asmtest.Start(n, -inc)))
+}
+`,
+		},
+		{
+			name: "DotuUnitary",
+			only: "complex",
+			fuzz: `func FuzzDotuUnitary(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, _, _, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		x := newFuzzVector[{{.Type}}](s, int(n), 1, fuzzFront(off, 0))
+		y := newFuzzVector[{{.Type}}](s, int(n), 1, fuzzFront(off, 1))
+		xr, yr := x.Clone(), y.Clone()
+
+		got := DotuUnitary(x.Slice(), y.Slice())
+
+		var (
+			want  {{.Type}}
+			terms fuzzTerms
+		)
+		yv := yr.Slice()
+		for i, v := range xr.Slice() {
+			want += fuzzMul(yv[i], v)
+			addFuzzTerms(&terms, yv[i], v)
+		}
+		checkFuzzSum(t, "DotuUnitary", got, want, terms)
+		asmtest.CheckVector(t, "x", x, xr)
+		asmtest.CheckVector(t, "y", y, yr)
+	})
+}
+`,
+			incs: "PageUnitaryIncs",
+			page: `x := asmtest.PageVector[{{.Type}}](p, n, 1)
+y := asmtest.PageVector[{{.Type}}](p, n, 1)
+DotuUnitary(x, y)
+`,
+			acc: "asmtest.Accuracy{Cond: 1}",
+			accuracy: `x := asmtest.AccuracyVector[{{.Type}}](rnd, n, 1)
+y := asmtest.AccuracyVector[{{.Type}}](rnd, n, 1)
+return asmtest.CompareExact(DotuUnitary(x, y), asmtest.ExactDot(x, y), acc)
+`,
+			cost: "asmtest.Cost{Flops: {{if .Complex}}8{{else}}2{{end}}, Bytes: 2 * {{.Size}}}",
+			bench: `x := asmtest.BenchVector[{{.Type}}](n, 1)
+y := asmtest.BenchVector[{{.Type}}](n, 1)
+return func() {
+	kernelSink = DotuUnitary(x, y)
+}
+`,
+		},
+		{
+			name: "DotuInc",
+			only: "complex",
+			incs: "PageIncs",
+			page: `x := asmtest.PageVector[{{.Type}}](p, n, inc)
+y := asmtest.PageVector[{{.Type}}](p, n, -inc)
+DotuInc(x, y, uintptr(n), uintptr(inc), uintptr(-inc), uintptr(asmtest.Start(n, inc)), uintptr(asmtest.Start(n, -inc)))
+`,
+			acc: "asmtest.Accuracy{Cond: 1}",
+			accuracy: `x := asmtest.AccuracyVector[{{.Type}}](rnd, n, inc)
+y := asmtest.AccuracyVector[{{.Type}}](rnd, n, -inc)
+got := DotuInc(x, y, uintptr(n), uintptr(inc), uintptr(-inc), uintptr(asmtest.Start(n, inc)), uintptr(asmtest.Start(n, -inc)))
+return asmtest.CompareExact(got, asmtest.ExactDot(asmtest.Elems(x, n, inc), asmtest.Elems(y, n, -inc)), acc)
+`,
+			cost: "asmtest.Cost{Flops: {{if .Complex}}8{{else}}2{{end}}, Bytes: 2 * {{.Size}}}",
+			bench: `x := asmtest.BenchVector[{{.Type}}](n, inc)
+y := asmtest.BenchVector[{{.Type}}](n, -inc)
+return func() {
+	kernelSink = DotuInc(x, y, uintptr(n), uintptr(inc), uintptr(-inc), uintptr(asmtest.Start(n, inc)), uintptr(asmtest.Start(n, -inc)))
+}
+`,
+		},
+		{
+			name: "DotcUnitary",
+			only: "complex",
+			fuzz: `func FuzzDotcUnitary(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, _, _, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		x := newFuzzVector[{{.Type}}](s, int(n), 1, fuzzFront(off, 0))
+		y := newFuzzVector[{{.Type}}](s, int(n), 1, fuzzFront(off, 1))
+		xr, yr := x.Clone(), y.Clone()
+
+		got := DotcUnitary(x.Slice(), y.Slice())
+
+		var (
+			want  {{.Type}}
+			terms fuzzTerms
+		)
+		yv := yr.Slice()
+		for i, v := range xr.Slice() {
+			want += fuzzMul(yv[i], {{.Conj}}(v))
+			addFuzzTerms(&terms, yv[i], {{.Conj}}(v))
+		}
+		checkFuzzSum(t, "DotcUnitary", got, want, terms)
+		asmtest.CheckVector(t, "x", x, xr)
+		asmtest.CheckVector(t, "y", y, yr)
+	})
+}
+`,
+			incs: "PageUnitaryIncs",
+			page: `x := asmtest.PageVector[{{.Type}}](p, n, 1)
+y := asmtest.PageVector[{{.Type}}](p, n, 1)
+DotcUnitary(x, y)
+`,
+			acc: "asmtest.Accuracy{Cond: 1}",
+			accuracy: `x := asmtest.AccuracyVector[{{.Type}}](rnd, n, 1)
+y := asmtest.AccuracyVector[{{.Type}}](rnd, n, 1)
+return asmtest.CompareExact(DotcUnitary(x, y), asmtest.ExactDot(conjElems(x), y), acc)
+`,
+			cost: "asmtest.Cost{Flops: {{if .Complex}}8{{else}}2{{end}}, Bytes: 2 * {{.Size}}}",
+			bench: `x := asmtest.BenchVector[{{.Type}}](n, 1)
+y := asmtest.BenchVector[{{.Type}}](n, 1)
+return func() {
+	kernelSink = DotcUnitary(x, y)
+}
+`,
+		},
+		{
+			name: "DotcInc",
+			only: "complex",
+			incs: "PageIncs",
+			page: `x := asmtest.PageVector[{{.Type}}](p, n, inc)
+y := asmtest.PageVector[{{.Type}}](p, n, -inc)
+DotcInc(x, y, uintptr(n), uintptr(inc), uintptr(-inc), uintptr(asmtest.Start(n, inc)), uintptr(asmtest.Start(n, -inc)))
+`,
+			acc: "asmtest.Accuracy{Cond: 1}",
+			accuracy: `x := asmtest.AccuracyVector[{{.Type}}](rnd, n, inc)
+y := asmtest.AccuracyVector[{{.Type}}](rnd, n, -inc)
+got := DotcInc(x, y, uintptr(n), uintptr(inc), uintptr(-inc), uintptr(asmtest.Start(n, inc)), uintptr(asmtest.Start(n, -inc)))
+return asmtest.CompareExact(got, asmtest.ExactDot(conjElems(asmtest.Elems(x, n, inc)), asmtest.Elems(y, n, -inc)), acc)
+`,
+			cost: "asmtest.Cost{Flops: {{if .Complex}}8{{else}}2{{end}}, Bytes: 2 * {{.Size}}}",
+			bench: `x := asmtest.BenchVector[{{.Type}}](n, inc)
+y := asmtest.BenchVector[{{.Type}}](n, -inc)
+return func() {
+	kernelSink = DotcInc(x, y, uintptr(n), uintptr(inc), uintptr(-inc), uintptr(asmtest.Start(n, inc)), uintptr(asmtest.Start(n, -inc)))
+}
+`,
+		},
+	}},
+	{name: "scal", kernels: []kernel{
+		{
+			name: "ScalUnitary",
+			fuzz: `func FuzzScalUnitary(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, _, _, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		alpha := fuzzValue[{{.Type}}](s)
+		x := newFuzzVector[{{.Type}}](s, int(n), 1, fuzzFront(off, 0))
+		xr := x.Clone()
+
+		ScalUnitary(alpha, x.Slice())
+
+		xv := xr.Slice()
+		for i := range xv {
+			xv[i] = fuzzMul(xv[i], alpha)
+		}
+		asmtest.CheckVector(t, "x", x, xr)
+	})
+}
+`,
+			incs: "PageUnitaryIncs",
+			page: `x := asmtest.PageVector[{{.Type}}](p, n, 1)
+ScalUnitary(2, x)
+`,
+			cost: "asmtest.Cost{Flops: {{if .Complex}}6{{else}}1{{end}}, Bytes: 2 * {{.Size}}}",
+			bench: `x := asmtest.BenchVector[{{.Type}}](n, 1)
+return func() {
+	ScalUnitary(1, x)
+}
+`,
+		},
+		{
+			name: "ScalUnitaryTo",
+			fuzz: `func FuzzScalUnitaryTo(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, _, _, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		alpha := fuzzValue[{{.Type}}](s)
+		x := newFuzzVector[{{.Type}}](s, int(n), 1, fuzzFront(off, 0))
+		dst := newFuzzVector[{{.Type}}](s, int(n), 1, fuzzFront(off, 2))
+		xr, dstr := x.Clone(), dst.Clone()
+
+		ScalUnitaryTo(dst.Slice(), alpha, x.Slice())
+
+		dv := dstr.Slice()
+		for i, v := range xr.Slice() {
+			dv[i] = fuzzMul(alpha, v)
+		}
+		asmtest.CheckVector(t, "x", x, xr)
+		asmtest.CheckVector(t, "dst", dst, dstr)
+	})
+}
+`,
+			incs: "PageUnitaryIncs",
+			page: `x := asmtest.PageVector[{{.Type}}](p, n, 1)
+dst := asmtest.PageVector[{{.Type}}](p, n, 1)
+ScalUnitaryTo(dst, 2, x)
+`,
+			cost: "asmtest.Cost{Flops: {{if .Complex}}6{{else}}1{{end}}, Bytes: 2 * {{.Size}}}",
+			bench: `x := asmtest.BenchVector[{{.Type}}](n, 1)
+dst := asmtest.BenchVector[{{.Type}}](n, 1)
+return func() {
+	ScalUnitaryTo(dst, 1, x)
+}
+`,
+		},
+		{
+			name: "ScalInc",
+			fuzz: `func FuzzScalInc(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, incX, _, _ int8, off uint8) {
+		s := &fuzzSource{data: data}
+		alpha := fuzzValue[{{.Type}}](s)
+		ln, incx := int(n), fuzzPosInc(incX)
+		x := newFuzzVector[{{.Type}}](s, ln, incx, fuzzFront(off, 0))
+		xr := x.Clone()
+
+		ScalInc(alpha, x.Slice(), uintptr(ln), uintptr(incx))
+
+		xv := xr.Slice()
+		for i := 0; i < ln; i++ {
+			xv[i*incx] = fuzzMul(alpha, xv[i*incx])
+		}
+		asmtest.CheckVector(t, "x", x, xr)
+	})
+}
+`,
+			incs: "PagePosIncs",
+			page: `x := asmtest.PageVector[{{.Type}}](p, n, inc)
+ScalInc(2, x, uintptr(n), uintptr(inc))
+`,
+			cost: "asmtest.Cost{Flops: {{if .Complex}}6{{else}}1{{end}}, Bytes: 2 * {{.Size}}}",
+			bench: `x := asmtest.BenchVector[{{.Type}}](n, inc)
+return func() {
+	ScalInc(1, x, uintptr(n), uintptr(inc))
+}
+`,
+		},
+		{
+			name: "ScalIncTo",
+			fuzz: `func FuzzScalIncTo(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n uint8, incX, _, incDst int8, off uint8) {
+		s := &fuzzSource{data: data}
+		alpha := fuzzValue[{{.Type}}](s)
+		ln, incx, incd := int(n), fuzzPosInc(incX), fuzzPosInc(incDst)
+		x := newFuzzVector[{{.Type}}](s, ln, incx, fuzzFront(off, 0))
+		dst := newFuzzVector[{{.Type}}](s, ln, incd, fuzzFront(off, 2))
+		xr, dstr := x.Clone(), dst.Clone()
+
+		ScalIncTo(dst.Slice(), uintptr(incd), alpha, x.Slice(), uintptr(ln), uintptr(incx))
+
+		xv, dv := xr.Slice(), dstr.Slice()
+		for i := 0; i < ln; i++ {
+			dv[i*incd] = fuzzMul(alpha, xv[i*incx])
+		}
+		asmtest.CheckVector(t, "x", x, xr)
+		asmtest.CheckVector(t, "dst", dst, dstr)
+	})
+}
+`,
+			incs: "PagePosIncs",
+			page: `x := asmtest.PageVector[{{.Type}}](p, n, inc)
+dst := asmtest.PageVector[{{.Type}}](p, n, inc)
+ScalIncTo(dst, uintptr(inc), 2, x, uintptr(n), uintptr(inc))
+`,
+			cost: "asmtest.Cost{Flops: {{if .Complex}}6{{else}}1{{end}}, Bytes: 2 * {{.Size}}}",
+			bench: `x := asmtest.BenchVector[{{.Type}}](n, inc)
+dst := asmtest.BenchVector[{{.Type}}](n, inc)
+return func() {
+	ScalIncTo(dst, uintptr(inc), 1, x, uintptr(n), uintptr(inc))
+}
+`,
+		},
+	}},
+}
+
+const benchTemplate = header + `package {{.Name}}
+
+{{if .Asm.ScalUnitary -}}
+import (
+	"math/rand"
+	"testing"
+)
+{{- else -}}
+import "testing"
+{{- end}}
+
+var (
+	a = {{.Two}}
+	x = make([]{{.Type}}, 1000000)
+	y = make([]{{.Type}}, 1000000)
+	z = make([]{{.Type}}, 1000000)
+)
+
+func init() {
+	for n := range x {
+		x[n] = {{.Index}}
+		y[n] = {{.Index}}
+	}
+}
+
+func benchaxpyu(t *testing.B, n int, f func(a {{.Type}}, x, y []{{.Type}})) {
+	x, y := x[:n], y[:n]
+	for i := 0; i < t.N; i++ {
+		f(a, x, y)
+	}
+}
+
+func naiveaxpyu(a {{.Type}}, x, y []{{.Type}}) {
+	for i, v := range x {
+		y[i] += a * v
+	}
+}
+
+{{unitary (print .Prefix "AxpyUnitary") "benchaxpyu" "AxpyUnitary"}}
+{{unitary (print "L" .Prefix "AxpyUnitary") "benchaxpyu" "naiveaxpyu"}}
+func benchaxpyut(t *testing.B, n int, f func(d []{{.Type}}, a {{.Type}}, x, y []{{.Type}})) {
+	x, y, z := x[:n], y[:n], z[:n]
+	for i := 0; i < t.N; i++ {
+		f(z, a, x, y)
+	}
+}
+
+func naiveaxpyut(d []{{.Type}}, a {{.Type}}, x, y []{{.Type}}) {
+	for i, v := range x {
+		d[i] = y[i] + a*v
+	}
+}
+
+{{unitary (print .Prefix "AxpyUnitaryTo") "benchaxpyut" "AxpyUnitaryTo"}}
+{{unitary (print "L" .Prefix "AxpyUnitaryTo") "benchaxpyut" "naiveaxpyut"}}
+func benchaxpyinc(t *testing.B, ln, t_inc int, f func(alpha {{.Type}}, x, y []{{.Type}}, n, incX, incY, ix, iy uintptr)) {
+	n, inc := uintptr(ln), uintptr(t_inc)
+	var idx int
+	if t_inc < 0 {
+		idx = (-ln + 1) * t_inc
+	}
+	for i := 0; i < t.N; i++ {
+		f({{.One}}, x, y, n, inc, inc, uintptr(idx), uintptr(idx))
+	}
+}
+
+func naiveaxpyinc(alpha {{.Type}}, x, y []{{.Type}}, n, incX, incY, ix, iy uintptr) {
+	for i := 0; i < int(n); i++ {
+		y[iy] += alpha * x[ix]
+		ix += incX
+		iy += incY
+	}
+}
+
+{{inc (print .Prefix "AxpyInc") "benchaxpyinc" "AxpyInc"}}
+{{inc (print "L" .Prefix "AxpyInc") "benchaxpyinc" "naiveaxpyinc"}}
+func benchaxpyincto(t *testing.B, ln, t_inc int, f func(dst []{{.Type}}, incDst, idst uintptr, alpha {{.Type}}, x, y []{{.Type}}, n, incX, incY, ix, iy uintptr)) {
+	n, inc := uintptr(ln), uintptr(t_inc)
+	var idx int
+	if t_inc < 0 {
+		idx = (-ln + 1) * t_inc
+	}
+	for i := 0; i < t.N; i++ {
+		f(z, inc, uintptr(idx), {{.One}}, x, y, n, inc, inc, uintptr(idx), uintptr(idx))
+	}
+}
+
+func naiveaxpyincto(dst []{{.Type}}, incDst, idst uintptr, alpha {{.Type}}, x, y []{{.Type}}, n, incX, incY, ix, iy uintptr) {
+	for i := 0; i < int(n); i++ {
+		dst[idst] = alpha*x[ix] + y[iy]
+		ix += incX
+		iy += incY
+		idst += incDst
+	}
+}
+
+{{inc (print .Prefix "AxpyIncTo") "benchaxpyincto" "AxpyIncTo"}}
+{{inc (print "L" .Prefix "AxpyIncTo") "benchaxpyincto" "naiveaxpyincto"}}
+{{- if .Asm.ScalUnitary}}
+// Scal* benchmarks
+func BenchmarkDscalUnitaryN1(b *testing.B)      { benchmarkDscalUnitary(b, 1) }
+func BenchmarkDscalUnitaryN2(b *testing.B)      { benchmarkDscalUnitary(b, 2) }
+func BenchmarkDscalUnitaryN3(b *testing.B)      { benchmarkDscalUnitary(b, 3) }
+func BenchmarkDscalUnitaryN4(b *testing.B)      { benchmarkDscalUnitary(b, 4) }
+func BenchmarkDscalUnitaryN10(b *testing.B)     { benchmarkDscalUnitary(b, 10) }
+func BenchmarkDscalUnitaryN100(b *testing.B)    { benchmarkDscalUnitary(b, 100) }
+func BenchmarkDscalUnitaryN1000(b *testing.B)   { benchmarkDscalUnitary(b, 1000) }
+func BenchmarkDscalUnitaryN10000(b *testing.B)  { benchmarkDscalUnitary(b, 10000) }
+func BenchmarkDscalUnitaryN100000(b *testing.B) { benchmarkDscalUnitary(b, 100000) }
+
+func benchmarkDscalUnitary(b *testing.B, n int) {
+	x := randomSlice(n, 1)
+	b.ResetTimer()
+	for i := 0; i < b.N; i += 2 {
+		ScalUnitary(2, x)
+		ScalUnitary(0.5, x)
+	}
+	benchSink = x
+}
+
+func BenchmarkDscalUnitaryToN1(b *testing.B)      { benchmarkDscalUnitaryTo(b, 1) }
+func BenchmarkDscalUnitaryToN2(b *testing.B)      { benchmarkDscalUnitaryTo(b, 2) }
+func BenchmarkDscalUnitaryToN3(b *testing.B)      { benchmarkDscalUnitaryTo(b, 3) }
+func BenchmarkDscalUnitaryToN4(b *testing.B)      { benchmarkDscalUnitaryTo(b, 4) }
+func BenchmarkDscalUnitaryToN10(b *testing.B)     { benchmarkDscalUnitaryTo(b, 10) }
+func BenchmarkDscalUnitaryToN100(b *testing.B)    { benchmarkDscalUnitaryTo(b, 100) }
+func BenchmarkDscalUnitaryToN1000(b *testing.B)   { benchmarkDscalUnitaryTo(b, 1000) }
+func BenchmarkDscalUnitaryToN10000(b *testing.B)  { benchmarkDscalUnitaryTo(b, 10000) }
+func BenchmarkDscalUnitaryToN100000(b *testing.B) { benchmarkDscalUnitaryTo(b, 100000) }
+
+func benchmarkDscalUnitaryTo(b *testing.B, n int) {
+	x := randomSlice(n, 1)
+	dst := randomSlice(n, 1)
+	a := rand.Float64()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ScalUnitaryTo(dst, a, x)
+	}
+	benchSink = dst
+}
+
+func BenchmarkDscalUnitaryToXN1(b *testing.B)      { benchmarkDscalUnitaryToX(b, 1) }
+func BenchmarkDscalUnitaryToXN2(b *testing.B)      { benchmarkDscalUnitaryToX(b, 2) }
+func BenchmarkDscalUnitaryToXN3(b *testing.B)      { benchmarkDscalUnitaryToX(b, 3) }
+func BenchmarkDscalUnitaryToXN4(b *testing.B)      { benchmarkDscalUnitaryToX(b, 4) }
+func BenchmarkDscalUnitaryToXN10(b *testing.B)     { benchmarkDscalUnitaryToX(b, 10) }
+func BenchmarkDscalUnitaryToXN100(b *testing.B)    { benchmarkDscalUnitaryToX(b, 100) }
+func BenchmarkDscalUnitaryToXN1000(b *testing.B)   { benchmarkDscalUnitaryToX(b, 1000) }
+func BenchmarkDscalUnitaryToXN10000(b *testing.B)  { benchmarkDscalUnitaryToX(b, 10000) }
+func BenchmarkDscalUnitaryToXN100000(b *testing.B) { benchmarkDscalUnitaryToX(b, 100000) }
+
+func benchmarkDscalUnitaryToX(b *testing.B, n int) {
+	x := randomSlice(n, 1)
+	b.ResetTimer()
+	for i := 0; i < b.N; i += 2 {
+		ScalUnitaryTo(x, 2, x)
+		ScalUnitaryTo(x, 0.5, x)
+	}
+	benchSink = x
+}
+
+func BenchmarkDscalIncN1Inc1(b *testing.B) { benchmarkDscalInc(b, 1, 1) }
+
+func BenchmarkDscalIncN2Inc1(b *testing.B)  { benchmarkDscalInc(b, 2, 1) }
+func BenchmarkDscalIncN2Inc2(b *testing.B)  { benchmarkDscalInc(b, 2, 2) }
+func BenchmarkDscalIncN2Inc4(b *testing.B)  { benchmarkDscalInc(b, 2, 4) }
+func BenchmarkDscalIncN2Inc10(b *testing.B) { benchmarkDscalInc(b, 2, 10) }
+
+func BenchmarkDscalIncN3Inc1(b *testing.B)  { benchmarkDscalInc(b, 3, 1) }
+func BenchmarkDscalIncN3Inc2(b *testing.B)  { benchmarkDscalInc(b, 3, 2) }
+func BenchmarkDscalIncN3Inc4(b *testing.B)  { benchmarkDscalInc(b, 3, 4) }
+func BenchmarkDscalIncN3Inc10(b *testing.B) { benchmarkDscalInc(b, 3, 10) }
+
+func BenchmarkDscalIncN4Inc1(b *testing.B)  { benchmarkDscalInc(b, 4, 1) }
+func BenchmarkDscalIncN4Inc2(b *testing.B)  { benchmarkDscalInc(b, 4, 2) }
+func BenchmarkDscalIncN4Inc4(b *testing.B)  { benchmarkDscalInc(b, 4, 4) }
+func BenchmarkDscalIncN4Inc10(b *testing.B) { benchmarkDscalInc(b, 4, 10) }
+
+func BenchmarkDscalIncN10Inc1(b *testing.B)  { benchmarkDscalInc(b, 10, 1) }
+func BenchmarkDscalIncN10Inc2(b *testing.B)  { benchmarkDscalInc(b, 10, 2) }
+func BenchmarkDscalIncN10Inc4(b *testing.B)  { benchmarkDscalInc(b, 10, 4) }
+func BenchmarkDscalIncN10Inc10(b *testing.B) { benchmarkDscalInc(b, 10, 10) }
+
+func BenchmarkDscalIncN1000Inc1(b *testing.B)  { benchmarkDscalInc(b, 1000, 1) }
+func BenchmarkDscalIncN1000Inc2(b *testing.B)  { benchmarkDscalInc(b, 1000, 2) }
+func BenchmarkDscalIncN1000Inc4(b *testing.B)  { benchmarkDscalInc(b, 1000, 4) }
+func BenchmarkDscalIncN1000Inc10(b *testing.B) { benchmarkDscalInc(b, 1000, 10) }
+
+func BenchmarkDscalIncN100000Inc1(b *testing.B)  { benchmarkDscalInc(b, 100000, 1) }
+func BenchmarkDscalIncN100000Inc2(b *testing.B)  { benchmarkDscalInc(b, 100000, 2) }
+func BenchmarkDscalIncN100000Inc4(b *testing.B)  { benchmarkDscalInc(b, 100000, 4) }
+func BenchmarkDscalIncN100000Inc10(b *testing.B) { benchmarkDscalInc(b, 100000, 10) }
+
+func benchmarkDscalInc(b *testing.B, n, inc int) {
+	x := randomSlice(n, inc)
+	b.ResetTimer()
+	for i := 0; i < b.N; i += 2 {
+		ScalInc(2, x, uintptr(n), uintptr(inc))
+		ScalInc(0.5, x, uintptr(n), uintptr(inc))
+	}
+	benchSink = x
+}
+
+func BenchmarkDscalIncToN1Inc1(b *testing.B) { benchmarkDscalIncTo(b, 1, 1) }
+
+func BenchmarkDscalIncToN2Inc1(b *testing.B)  { benchmarkDscalIncTo(b, 2, 1) }
+func BenchmarkDscalIncToN2Inc2(b *testing.B)  { benchmarkDscalIncTo(b, 2, 2) }
+func BenchmarkDscalIncToN2Inc4(b *testing.B)  { benchmarkDscalIncTo(b, 2, 4) }
+func BenchmarkDscalIncToN2Inc10(b *testing.B) { benchmarkDscalIncTo(b, 2, 10) }
+
+func BenchmarkDscalIncToN3Inc1(b *testing.B)  { benchmarkDscalIncTo(b, 3, 1) }
+func BenchmarkDscalIncToN3Inc2(b *testing.B)  { benchmarkDscalIncTo(b, 3, 2) }
+func BenchmarkDscalIncToN3Inc4(b *testing.B)  { benchmarkDscalIncTo(b, 3, 4) }
+func BenchmarkDscalIncToN3Inc10(b *testing.B) { benchmarkDscalIncTo(b, 3, 10) }
+
+func BenchmarkDscalIncToN4Inc1(b *testing.B)  { benchmarkDscalIncTo(b, 4, 1) }
+func BenchmarkDscalIncToN4Inc2(b *testing.B)  { benchmarkDscalIncTo(b, 4, 2) }
+func BenchmarkDscalIncToN4Inc4(b *testing.B)  { benchmarkDscalIncTo(b, 4, 4) }
+func BenchmarkDscalIncToN4Inc10(b *testing.B) { benchmarkDscalIncTo(b, 4, 10) }
+
+func BenchmarkDscalIncToN10Inc1(b *testing.B)  { benchmarkDscalIncTo(b, 10, 1) }
+func BenchmarkDscalIncToN10Inc2(b *testing.B)  { benchmarkDscalIncTo(b, 10, 2) }
+func BenchmarkDscalIncToN10Inc4(b *testing.B)  { benchmarkDscalIncTo(b, 10, 4) }
+func BenchmarkDscalIncToN10Inc10(b *testing.B) { benchmarkDscalIncTo(b, 10, 10) }
+
+func BenchmarkDscalIncToN1000Inc1(b *testing.B)  { benchmarkDscalIncTo(b, 1000, 1) }
+func BenchmarkDscalIncToN1000Inc2(b *testing.B)  { benchmarkDscalIncTo(b, 1000, 2) }
+func BenchmarkDscalIncToN1000Inc4(b *testing.B)  { benchmarkDscalIncTo(b, 1000, 4) }
+func BenchmarkDscalIncToN1000Inc10(b *testing.B) { benchmarkDscalIncTo(b, 1000, 10) }
+
+func BenchmarkDscalIncToN100000Inc1(b *testing.B)  { benchmarkDscalIncTo(b, 100000, 1) }
+func BenchmarkDscalIncToN100000Inc2(b *testing.B)  { benchmarkDscalIncTo(b, 100000, 2) }
+func BenchmarkDscalIncToN100000Inc4(b *testing.B)  { benchmarkDscalIncTo(b, 100000, 4) }
+func BenchmarkDscalIncToN100000Inc10(b *testing.B) { benchmarkDscalIncTo(b, 100000, 10) }
+
+func benchmarkDscalIncTo(b *testing.B, n, inc int) {
+	x := randomSlice(n, inc)
+	dst := randomSlice(n, inc)
+	a := rand.Float64()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ScalIncTo(dst, uintptr(inc), a, x, uintptr(n), uintptr(inc))
+	}
+	benchSink = dst
+}
+{{end}}`
+
+const scalTestTemplate = header + `package {{.Name}}
+
+import "testing"
+
+var scalTests = []struct {
+	alpha {{.Type}}
+	x     []{{.Type}}
+}{
+	{alpha: 0, x: []{{.Type}}{}},
+	{alpha: 0, x: []{{.Type}}{1, 2, 3}},
+	{alpha: 1, x: []{{.Type}}{1, 2, 3}},
+	{alpha: -2, x: []{{.Type}}{1, -2, 3, -4, 5}},
+{{- if .Complex}}
+	{alpha: 1i, x: []{{.Type}}{1, 1i, -1, -1i, 2 + 3i}},
+	{alpha: 2 - 1i, x: []{{.Type}}{1 + 1i, 2, 3i, -4 - 1i, 5, 6 + 2i, 7, 8 - 8i, 9, 10}},
+{{- else}}
+	{alpha: 0.5, x: []{{.Type}}{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}},
+{{- end}}
+}
+
+func TestScalUnitary(t *testing.T) {
+	const xGdVal = -0.5
+	for cas, test := range scalTests {
+		for _, align := range []int{0, 1} {
+			xgLn := 4 + align
+			xg := guardVector(test.x, xGdVal, xgLn)
+			x := xg[xgLn : len(xg)-xgLn]
+			ScalUnitary(test.alpha, x)
+			for i, v := range test.x {
+				if want := test.alpha * v; x[i] != want {
+					t.Errorf("test %d: unexpected result at %d: got %v want %v", cas, i, x[i], want)
+				}
+			}
+			if !isValidGuard(xg, xGdVal, xgLn) {
+				t.Errorf("test %d: guard violated in x vector %v %v", cas, xg[:xgLn], xg[len(xg)-xgLn:])
+			}
+		}
+	}
+}
+
+func TestScalUnitaryTo(t *testing.T) {
+	const xGdVal, dstGdVal = -1, 0.5
+	for cas, test := range scalTests {
+		for _, align := range []int{0, 1} {
+			xgLn, dgLn := 4+align, 4+2*align
+			xg := guardVector(test.x, xGdVal, xgLn)
+			x := xg[xgLn : len(xg)-xgLn]
+			dg := guardVector(make([]{{.Type}}, len(test.x)), dstGdVal, dgLn)
+			dst := dg[dgLn : len(dg)-dgLn]
+			ScalUnitaryTo(dst, test.alpha, x)
+			for i, v := range test.x {
+				if want := test.alpha * v; dst[i] != want {
+					t.Errorf("test %d: unexpected result at %d: got %v want %v", cas, i, dst[i], want)
+				}
+				if x[i] != v {
+					t.Errorf("test %d: x modified at %d: got %v want %v", cas, i, x[i], v)
+				}
+			}
+			if !isValidGuard(xg, xGdVal, xgLn) {
+				t.Errorf("test %d: guard violated in x vector %v %v", cas, xg[:xgLn], xg[len(xg)-xgLn:])
+			}
+			if !isValidGuard(dg, dstGdVal, dgLn) {
+				t.Errorf("test %d: guard violated in dst vector %v %v", cas, dg[:dgLn], dg[len(dg)-dgLn:])
+			}
+		}
+	}
+}
+
+func TestScalInc(t *testing.T) {
+	const xGdVal = -0.5
+	for cas, test := range scalTests {
+		for _, inc := range []int{1, 2, 3, 4} {
+			xgLn := 4 + inc
+			xg := guardIncVector(test.x, xGdVal, uintptr(inc), xgLn)
+			x := xg[xgLn : len(xg)-xgLn]
+			ScalInc(test.alpha, x, uintptr(len(test.x)), uintptr(inc))
+			for i, v := range test.x {
+				if want := test.alpha * v; x[i*inc] != want {
+					t.Errorf("test %d inc %d: unexpected result at %d: got %v want %v", cas, inc, i, x[i*inc], want)
+				}
+			}
+			checkValidIncGuard(t, xg, xGdVal, uintptr(inc), xgLn)
+		}
+	}
+}
+
+func TestScalIncTo(t *testing.T) {
+	const xGdVal, dstGdVal = -1, 0.5
+	for cas, test := range scalTests {
+		for _, inc := range []struct{ x, dst int }{
+			{x: 1, dst: 1},
+			{x: 2, dst: 3},
+			{x: 3, dst: 2},
+			{x: 4, dst: 4},
+		} {
+			xgLn, dgLn := 4+inc.x, 4+inc.dst
+			xg := guardIncVector(test.x, xGdVal, uintptr(inc.x), xgLn)
+			x := xg[xgLn : len(xg)-xgLn]
+			dg := guardIncVector(make([]{{.Type}}, len(test.x)), dstGdVal, uintptr(inc.dst), dgLn)
+			dst := dg[dgLn : len(dg)-dgLn]
+			ScalIncTo(dst, uintptr(inc.dst), test.alpha, x, uintptr(len(test.x)), uintptr(inc.x))
+			for i, v := range test.x {
+				if want := test.alpha * v; dst[i*inc.dst] != want {
+					t.Errorf("test %d inc %v: unexpected result at %d: got %v want %v", cas, inc, i, dst[i*inc.dst], want)
+				}
+				if x[i*inc.x] != v {
+					t.Errorf("test %d inc %v: x modified at %d: got %v want %v", cas, inc, i, x[i*inc.x], v)
+				}
+			}
+			checkValidIncGuard(t, xg, xGdVal, uintptr(inc.x), xgLn)
+			checkValidIncGuard(t, dg, dstGdVal, uintptr(inc.dst), dgLn)
+		}
+	}
+}
+`
+
+const fuzzTemplate = header + `//go:build go1.18 && !noasm && !appengine
+// +build go1.18,!noasm,!appengine
+
+package {{.Name}}
+
+import (
+	"math"
+{{- if eq .Conj "cmplx.Conj"}}
+	"math/cmplx"
+{{- end}}
+	"math/rand"
+	"testing"
+
+	"github.com/gonum/internal/asm/internal/asmtest"
+)
+
+// The fuzz targets in this package compare the assembly kernels with
+// Go references on vectors decoded from the fuzzer's input. Every target
+// takes the same arguments so that addFuzzSeeds can seed them all: data
+// supplies the scalars and vector elements, n is the vector length, the
+// int8 arguments are increments, which only the strided kernels use, and
+// off selects the number of guard elements ahead of each vector, which
+// varies vector alignment.
+
+// fuzzSource decodes fuzzer input into kernel arguments. Reads past
+// the end of the input return zero.
+type fuzzSource struct {
+	data []byte
+}
+
+func (s *fuzzSource) byte() byte {
+	if len(s.data) == 0 {
+		return 0
+	}
+	b := s.data[0]
+	s.data = s.data[1:]
+	return b
+}
+
+func (s *fuzzSource) bits(n int) uint64 {
+	var u uint64
+	for i := 0; i < n; i++ {
+		u |= uint64(s.byte()) << (8 * uint(i))
+	}
+	return u
+}
+
+var (
+	fuzzSpecials64 = []float64{
+		0, math.Copysign(0, -1), 1, -1, 0.5,
+		math.NaN(), math.Inf(1), math.Inf(-1),
+		math.SmallestNonzeroFloat64, -math.SmallestNonzeroFloat64,
+		math.Float64frombits(0x000fffffffffffff), 0x1p-1022,
+		math.MaxFloat64, -math.MaxFloat64,
+	}
+	fuzzSpecials32 = []float32{
+		0, float32(math.Copysign(0, -1)), 1, -1, 0.5,
+		float32(math.NaN()), float32(math.Inf(1)), float32(math.Inf(-1)),
+		math.SmallestNonzeroFloat32, -math.SmallestNonzeroFloat32,
+		math.Float32frombits(0x007fffff), 0x1p-126,
+		math.MaxFloat32, -math.MaxFloat32,
+	}
+)
+
+// fuzzFloat64 returns the next value from s. A value is one of
+// fuzzSpecials64, a small integer, or arbitrary bits, which include
+// subnormals and NaNs with payloads.
+func fuzzFloat64(s *fuzzSource) float64 {
+	b := s.byte()
+	switch b % 4 {
+	case 0:
+		return fuzzSpecials64[int(b/4)%len(fuzzSpecials64)]
+	case 1:
+		return float64(int(b/4) - 32)
+	}
+	return math.Float64frombits(s.bits(8))
+}
+
+// fuzzFloat32 returns the next value from s in the same way as fuzzFloat64.
+func fuzzFloat32(s *fuzzSource) float32 {
+	b := s.byte()
+	switch b % 4 {
+	case 0:
+		return fuzzSpecials32[int(b/4)%len(fuzzSpecials32)]
+	case 1:
+		return float32(int(b/4) - 32)
+	}
+	return math.Float32frombits(uint32(s.bits(4)))
+}
+
+// fuzzValue returns the next value of type T from s.
+func fuzzValue[T asmtest.Elem](s *fuzzSource) T {
+	var v T
+	switch p := any(&v).(type) {
+	case *float32:
+		*p = fuzzFloat32(s)
+	case *float64:
+		*p = fuzzFloat64(s)
+	case *complex64:
+		*p = complex(fuzzFloat32(s), fuzzFloat32(s))
+	case *complex128:
+		*p = complex(fuzzFloat64(s), fuzzFloat64(s))
+	}
+	return v
+}
+
+// fuzzInc returns a non-zero increment in [-8, 8] for b.
+func fuzzInc(b int8) int {
+	inc := int(b % 9)
+	if inc == 0 {
+		inc = 1
+	}
+	return inc
+}
+
+// fuzzPosInc returns a positive increment in [1, 8] for b.
+func fuzzPosInc(b int8) int {
+	inc := fuzzInc(b)
+	if inc < 0 {
+		inc = -inc
+	}
+	return inc
+}
+
+// fuzzStart returns the index of the first element visited by a kernel
+// walking n elements with increment inc.
+func fuzzStart(n, inc int) int {
+	if inc < 0 && n > 0 {
+		return (n - 1) * -inc
+	}
+	return 0
+}
+
+// fuzzFront returns the number of guard elements ahead of the i'th
+// vector of a fuzz target for off.
+func fuzzFront(off uint8, i int) int {
+	return 1 + int(off>>(3*uint(i)))%8
+}
+
+// fuzzBack is the number of guard elements after each vector.
+const fuzzBack = 4
+
+// newFuzzVector returns a guarded vector of n elements read from s with
+// the absolute value of inc as its increment after front guard elements.
+func newFuzzVector[T asmtest.Elem](s *fuzzSource, n, inc, front int) *asmtest.Vector[T] {
+	data := make([]T, n)
+	for i := range data {
+		data[i] = fuzzValue[T](s)
+	}
+	return asmtest.NewVector(data, inc, front, fuzzBack)
+}
+
+// fuzzMul returns a*b rounded as the assembly kernels round it.
+// Complex64 products are formed in float32, as the SSE kernels form
+// them, rather than in float64 as the Go compiler does. The explicit
+// conversions prevent the compiler from fusing operations.
+func fuzzMul[T asmtest.Elem](a, b T) T {
+	switch a := any(a).(type) {
+	case complex64:
+		b := any(b).(complex64)
+		re := float32(real(a)*real(b)) - float32(imag(a)*imag(b))
+		im := float32(real(a)*imag(b)) + float32(imag(a)*real(b))
+		return any(complex(re, im)).(T)
+	case complex128:
+		b := any(b).(complex128)
+		re := float64(real(a)*real(b)) - float64(imag(a)*imag(b))
+		im := float64(real(a)*imag(b)) + float64(imag(a)*real(b))
+		return any(complex(re, im)).(T)
+	}
+	return T(a * b)
+}
+
+// fuzzTerms holds the real and imaginary components of the terms of
+// a reference sum.
+type fuzzTerms struct {
+	re, im []float64
+}
+
+// addFuzzTerms adds the components of fuzzMul(a, b) to terms.
+func addFuzzTerms[T asmtest.Elem](terms *fuzzTerms, a, b T) {
+	switch a := any(a).(type) {
+	case float32:
+		terms.re = append(terms.re, float64(a*any(b).(float32)))
+	case float64:
+		terms.re = append(terms.re, a*any(b).(float64))
+	case complex64:
+		b := any(b).(complex64)
+		terms.re = append(terms.re, float64(real(a)*real(b)), -float64(imag(a)*imag(b)))
+		terms.im = append(terms.im, float64(real(a)*imag(b)), float64(imag(a)*real(b)))
+	case complex128:
+		b := any(b).(complex128)
+		terms.re = append(terms.re, real(a)*real(b), -imag(a)*imag(b))
+		terms.im = append(terms.im, real(a)*imag(b), imag(a)*real(b))
+	}
+}
+
+// checkFuzzSum checks that got, a sum computed by an assembly kernel,
+// matches want, the same sum computed in order by its reference. The
+// kernels add the terms in a different order, so the results may differ
+// by the rounding of the additions. If the finite terms could overflow
+// in some order any result is accepted. Otherwise NaN and infinite
+// results do not depend on the order and must match.
+func checkFuzzSum[T asmtest.Elem](t *testing.T, name string, got, want T, terms fuzzTerms) {
+	t.Helper()
+	eps, max := 0x1p-53, math.MaxFloat64
+	switch any(got).(type) {
+	case float32, complex64:
+		eps, max = 0x1p-24, math.MaxFloat32
+	}
+	check := func(part string, got, want float64, terms []float64) {
+		var bound float64
+		for _, v := range terms {
+			if !math.IsInf(v, 0) && !math.IsNaN(v) {
+				bound += math.Abs(v)
+			}
+		}
+		tol := 2 * float64(len(terms)+2) * eps * bound
+		if bound+tol > max {
+			return
+		}
+		var ok bool
+		switch {
+		case math.IsNaN(want):
+			ok = math.IsNaN(got)
+		case math.IsInf(want, 0):
+			ok = got == want
+		default:
+			ok = math.Abs(got-want) <= tol
+		}
+		if !ok {
+			t.Errorf("%s: unexpected %sresult: got %v want %v", name, part, got, want)
+		}
+	}
+	switch g := any(got).(type) {
+	case float32:
+		check("", float64(g), float64(any(want).(float32)), terms.re)
+	case float64:
+		check("", g, any(want).(float64), terms.re)
+	case complex64:
+		w := any(want).(complex64)
+		check("real ", float64(real(g)), float64(real(w)), terms.re)
+		check("imaginary ", float64(imag(g)), float64(imag(w)), terms.im)
+	case complex128:
+		w := any(want).(complex128)
+		check("real ", real(g), real(w), terms.re)
+		check("imaginary ", imag(g), imag(w), terms.im)
+	}
+}
+
+// addFuzzSeeds adds the seed corpus shared by the fuzz targets: vectors
+// of special values and of random bits at lengths around the unrolling
+// boundaries of the kernels, with a range of increments and offsets.
+func addFuzzSeeds(f *testing.F) {
+	specials := make([]byte, 256)
+	for i := range specials {
+		specials[i] = byte(4 * i)
+	}
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []uint8{0, 1, 2, 3, 4, 5, 7, 8, 9, 15, 16, 17, 31, 32, 33, 63, 64, 65, 255} {
+		f.Add(specials, n, int8(1), int8(1), int8(1), uint8(0))
+		for _, inc := range [][3]int8{
+			{1, 1, 1},
+			{2, 3, 1},
+			{-1, 1, -2},
+			{-3, -2, 4},
+			{8, -8, 3},
+		} {
+			data := make([]byte, 4096)
+			rnd.Read(data)
+			f.Add(data, n, inc[0], inc[1], inc[2], uint8(rnd.Intn(256)))
+		}
+	}
+}
+`
+
+const pageTemplate = header + `//go:build go1.18 && linux
+// +build go1.18,linux
+
+package {{.Name}}
+
+import (
+	"testing"
+
+	"github.com/gonum/internal/asm/internal/asmtest"
+)
+
+// pageTest calls a kernel with vectors that lie flush against
+// inaccessible pages.
+type pageTest struct {
+	name   string
+	incs   []int
+	kernel func(p *asmtest.Pages, n, inc int)
+}
+
+// TestPageBoundaries checks that no kernel reads or writes outside its
+// vectors. The kernels not generated by asmgen are in otherPageTests.
+func TestPageBoundaries(t *testing.T) {
+	for _, tests := range [][]pageTest{generatedPageTests, otherPageTests} {
+		for _, test := range tests {
+			asmtest.CheckPages(t, test.name, test.incs, test.kernel)
+		}
+	}
+}
+
+var generatedPageTests = []pageTest{
+`
+
+const accuracyTemplate = header + `//go:build go1.18
+// +build go1.18
+
+package {{.Name}}
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/gonum/internal/asm/internal/asmtest"
+)
+
+// accuracyTest checks the results of a reduction kernel against their
+// exact values under the kernel's documented error bound.
+type accuracyTest struct {
+	name   string
+	incs   []int
+	acc    asmtest.Accuracy
+	kernel func(rnd *rand.Rand, n, inc int, acc asmtest.Accuracy) error
+}
+
+// TestAccuracy checks that every reduction kernel meets its documented
+// error bound. The kernels not generated by asmgen are in
+// otherAccuracyTests.
+func TestAccuracy(t *testing.T) {
+	for _, tests := range [][]accuracyTest{generatedAccuracyTests, otherAccuracyTests} {
+		for _, test := range tests {
+			asmtest.CheckAccuracy(t, test.name, test.incs, test.acc, test.kernel)
+		}
+	}
+}
+{{if .Complex}}
+// conjElems returns the conjugates of the elements of x.
+func conjElems(x []{{.Type}}) []{{.Type}} {
+	c := make([]{{.Type}}, len(x))
+	for i, v := range x {
+		c[i] = complex(real(v), -imag(v))
+	}
+	return c
+}
+{{end}}
+var generatedAccuracyTests = []accuracyTest{
+`
+
+const kernelBenchTemplate = header + `//go:build go1.18
+// +build go1.18
+
+package {{.Name}}
+
+import (
+	"testing"
+
+	"github.com/gonum/internal/asm/internal/asmtest"
+)
+
+// benchKernel benchmarks a kernel with vectors allocated by setup.
+type benchKernel struct {
+	name  string
+	incs  []int
+	cost  asmtest.Cost
+	setup func(n, inc int) func()
+}
+
+// kernelSink receives the results of the benchmarked reductions.
+var kernelSink {{.Type}}
+
+// BenchmarkKernels benchmarks every kernel over a range of lengths and
+// increments, reporting GFLOP/s and GB/s. The kernels not generated by
+// asmgen are in otherBenchKernels.
+func BenchmarkKernels(b *testing.B) {
+	for _, kernels := range [][]benchKernel{generatedBenchKernels, otherBenchKernels} {
+		for _, k := range kernels {
+			b.Run(k.name, func(b *testing.B) {
+				asmtest.Bench(b, k.incs, k.cost, k.setup)
+			})
+		}
+	}
+}
+
+var generatedBenchKernels = []benchKernel{
+`
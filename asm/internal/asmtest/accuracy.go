@@ -0,0 +1,331 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18
+// +build go1.18
+
+package asmtest
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// exactPrec is the precision of the exact references. It spans the
+// exponent range of products of finite float64 values with room for the
+// carries of any sum a test can form, so only square roots are rounded.
+const exactPrec = 4500
+
+// The increments passed to CheckAccuracy for strided kernels that accept
+// negative increments, for those that do not, and for unitary kernels.
+var (
+	AccuracyIncs        = []int{1, 3, -2}
+	AccuracyPosIncs     = []int{1, 3}
+	AccuracyUnitaryIncs = []int{1}
+)
+
+// accuracyLens are the vector lengths used by CheckAccuracy. They cover
+// the unrolling boundaries of the kernels and long vectors that expose
+// the growth of rounding error.
+var accuracyLens = []int{0, 1, 2, 3, 4, 5, 7, 8, 9, 15, 16, 17, 31, 32, 33, 64, 100, 1000}
+
+// accuracyTrials is the number of random vectors CheckAccuracy uses for
+// each length and increment.
+const accuracyTrials = 4
+
+// Exact is the exact result of a reduction together with the quantities
+// that bound the rounding error of computing it in floating point.
+type Exact struct {
+	// Re and Im are the real and imaginary parts of the result.
+	Re, Im *big.Float
+	// AbsRe and AbsIm are the sums of the absolute values of the
+	// terms that form Re and Im.
+	AbsRe, AbsIm *big.Float
+	// Terms is the number of terms that form each part.
+	Terms int
+}
+
+// NewExact returns an Exact for a result with no terms.
+func NewExact() *Exact {
+	return &Exact{Re: newBig(), Im: newBig(), AbsRe: newBig(), AbsIm: newBig()}
+}
+
+// AddTerm adds a term with real part re and imaginary part im to e. A nil
+// part is zero. AddTerm does not change e.Terms.
+func (e *Exact) AddTerm(re, im *big.Float) {
+	for _, p := range []struct {
+		sum, abs, v *big.Float
+	}{
+		{e.Re, e.AbsRe, re},
+		{e.Im, e.AbsIm, im},
+	} {
+		if p.v == nil {
+			continue
+		}
+		p.sum.Add(p.sum, p.v)
+		p.abs.Add(p.abs, newBig().Abs(p.v))
+	}
+}
+
+// Big returns the real and imaginary parts of v exactly.
+func Big[T Elem](v T) (re, im *big.Float) {
+	switch v := any(v).(type) {
+	case float32:
+		return newBig().SetFloat64(float64(v)), newBig()
+	case float64:
+		return newBig().SetFloat64(v), newBig()
+	case complex64:
+		return newBig().SetFloat64(float64(real(v))), newBig().SetFloat64(float64(imag(v)))
+	case complex128:
+		return newBig().SetFloat64(real(v)), newBig().SetFloat64(imag(v))
+	}
+	panic("unreachable")
+}
+
+func newBig() *big.Float {
+	return new(big.Float).SetPrec(exactPrec)
+}
+
+// ExactSum returns the exact sum of the elements of x.
+func ExactSum[T Elem](x []T) *Exact {
+	e := NewExact()
+	for _, v := range x {
+		e.AddTerm(Big(v))
+	}
+	e.Terms = len(x)
+	return e
+}
+
+// ExactDot returns the exact sum of the products x[i]*y[i]. Each part of
+// a complex product is two terms.
+func ExactDot[T Elem](x, y []T) *Exact {
+	e := NewExact()
+	for i, v := range x {
+		ar, ai := Big(v)
+		br, bi := Big(y[i])
+		e.AddTerm(newBig().Mul(ar, br), newBig().Mul(ar, bi))
+		e.AddTerm(newBig().Neg(newBig().Mul(ai, bi)), newBig().Mul(ai, br))
+	}
+	e.Terms = len(x)
+	if isComplex[T]() {
+		e.Terms *= 2
+	}
+	return e
+}
+
+// ExactProd returns the exact product of the real elements of x. The
+// bound of a product of n factors is that of a sum of n terms whose
+// absolute values sum to the absolute value of the product.
+func ExactProd[T Elem](x []T) *Exact {
+	e := NewExact()
+	e.Re.SetInt64(1)
+	for _, v := range x {
+		re, _ := Big(v)
+		e.Re.Mul(e.Re, re)
+	}
+	e.AbsRe.Abs(e.Re)
+	e.Terms = len(x)
+	return e
+}
+
+// ExactNorm returns the Euclidean norm of the real elements of x rounded
+// to the precision of the exact references. The bound of a norm of n
+// elements is that of a sum of n+1 terms, which covers the error of
+// summing the squares, halved by the square root, and of rounding the
+// square root.
+func ExactNorm[T Elem](x []T) *Exact {
+	e := NewExact()
+	for _, v := range x {
+		re, _ := Big(v)
+		e.Re.Add(e.Re, newBig().Mul(re, re))
+	}
+	e.Re.Sqrt(e.Re)
+	e.AbsRe.Set(e.Re)
+	e.Terms = len(x) + 1
+	return e
+}
+
+// Accuracy is the documented error bound of a kernel result. A result
+// part meets the bound if it is at most ULPs units in the last place from
+// the exact part rounded to the result type, or if its error is at most
+//  γ(Cond·m)·a, with γ(k) = k·u/(1-k·u),
+// where m is the number of terms that form the part, a is the sum of
+// their absolute values and u is the unit roundoff of the result type.
+// With Cond one this is the bound on the error of summing the m terms,
+// each rounded once, in any order, so it holds however a kernel splits
+// its accumulation. The ratio of the error to a is the error relative to
+// the condition number of the sum.
+type Accuracy struct {
+	ULPs uint64
+	Cond float64
+}
+
+// CompareExact returns an error if got, the result of a kernel, does not
+// meet the bound acc for the exact result want.
+func CompareExact[T Elem](got T, want *Exact, acc Accuracy) error {
+	u := 0x1p-53
+	if isSingle[T]() {
+		u = 0x1p-24
+	}
+	type part struct {
+		name      string
+		got       float64
+		want, abs *big.Float
+	}
+	re, im := parts(got)
+	checks := []part{{"result", re, want.Re, want.AbsRe}}
+	if isComplex[T]() {
+		checks = []part{
+			{"real part", re, want.Re, want.AbsRe},
+			{"imaginary part", im, want.Im, want.AbsIm},
+		}
+	}
+	for _, p := range checks {
+		if math.IsNaN(p.got) || math.IsInf(p.got, 0) {
+			return fmt.Errorf("%s is %v, want %v", p.name, p.got, p.want)
+		}
+		w, _ := p.want.Float64()
+		if isSingle[T]() {
+			f, _ := p.want.Float32()
+			w = float64(f)
+		}
+		ulps := ulps64(p.got, w)
+		if isSingle[T]() {
+			ulps = ulps32(float32(p.got), float32(w))
+		}
+		if ulps <= acc.ULPs {
+			continue
+		}
+		k := acc.Cond * float64(want.Terms) * u
+		if k >= 1 {
+			continue
+		}
+		diff := newBig().SetFloat64(p.got)
+		diff.Sub(diff, p.want).Abs(diff)
+		bound := newBig().Mul(p.abs, big.NewFloat(k/(1-k)))
+		if diff.Cmp(bound) <= 0 {
+			continue
+		}
+		// Report the error in units of m·u·a, the first order bound
+		// with Cond one.
+		rel := math.Inf(1)
+		if want.Terms > 0 && p.abs.Sign() != 0 {
+			r := newBig().Quo(diff, p.abs)
+			rel, _ = r.Float64()
+			rel /= float64(want.Terms) * u
+		}
+		return fmt.Errorf("%s is %v, %d ulps from %v and %.3g times m·u·a for m=%d, exceeding γ(%g·m)·a",
+			p.name, p.got, ulps, w, rel, want.Terms, acc.Cond)
+	}
+	return nil
+}
+
+// parts returns the real and imaginary parts of v.
+func parts[T Elem](v T) (re, im float64) {
+	switch v := any(v).(type) {
+	case float32:
+		return float64(v), 0
+	case float64:
+		return v, 0
+	case complex64:
+		return float64(real(v)), float64(imag(v))
+	case complex128:
+		return real(v), imag(v)
+	}
+	panic("unreachable")
+}
+
+func isSingle[T Elem]() bool {
+	var v T
+	switch any(v).(type) {
+	case float32, complex64:
+		return true
+	}
+	return false
+}
+
+func isComplex[T Elem]() bool {
+	var v T
+	switch any(v).(type) {
+	case complex64, complex128:
+		return true
+	}
+	return false
+}
+
+// AccuracyVector returns a vector of n random elements with increment
+// inc. Each part of an element has a random sign, a significand in
+// [1, 2) and a binary exponent in [-8, 8], so sums of the elements cancel
+// and their terms are rounded at different magnitudes. The elements
+// between those of the vector are guard values, which make a kernel that
+// reads them return NaN.
+func AccuracyVector[T Elem](rnd *rand.Rand, n, inc int) []T {
+	if inc < 0 {
+		inc = -inc
+	}
+	var span int
+	if n > 0 {
+		span = (n-1)*inc + 1
+	}
+	x := make([]T, span)
+	g := Guard[T]()
+	for i := range x {
+		x[i] = g
+	}
+	part := func() float64 {
+		v := math.Ldexp(1+rnd.Float64(), rnd.Intn(17)-8)
+		if rnd.Intn(2) == 0 {
+			v = -v
+		}
+		return v
+	}
+	for i := 0; i < n; i++ {
+		p := &x[i*inc]
+		switch p := any(p).(type) {
+		case *float32:
+			*p = float32(part())
+		case *float64:
+			*p = part()
+		case *complex64:
+			*p = complex(float32(part()), float32(part()))
+		case *complex128:
+			*p = complex(part(), part())
+		}
+	}
+	return x
+}
+
+// Elems returns the n elements of x with increment inc in the order a
+// kernel visits them, starting from Start(n, inc).
+func Elems[T Elem](x []T, n, inc int) []T {
+	e := make([]T, n)
+	for i, j := 0, Start(n, inc); i < n; i, j = i+1, j+inc {
+		e[i] = x[j]
+	}
+	return e
+}
+
+// CheckAccuracy calls kernel with random vectors of a range of lengths
+// for each of incs and reports an error through tb for the first call
+// whose result does not meet the bound acc. The kernel allocates its
+// vectors from rnd with AccuracyVector and returns the error from
+// CompareExact. Kernels without an increment should be checked with
+// AccuracyUnitaryIncs.
+func CheckAccuracy(tb testing.TB, name string, incs []int, acc Accuracy, kernel func(rnd *rand.Rand, n, inc int, acc Accuracy) error) {
+	tb.Helper()
+	rnd := rand.New(rand.NewSource(1))
+	for _, inc := range incs {
+		for _, n := range accuracyLens {
+			for trial := 0; trial < accuracyTrials; trial++ {
+				if err := kernel(rnd, n, inc, acc); err != nil {
+					tb.Errorf("%s: n=%d inc=%d trial=%d: %v", name, n, inc, trial, err)
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18
+// +build go1.18
+
+package asmtest
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestExact(t *testing.T) {
+	// The sum is 1, but adding the terms in order in float64 gives 0.
+	x := []float64{1, 0x1p60, -0x1p60}
+	e := ExactSum(x)
+	if got, _ := e.Re.Float64(); got != 1 {
+		t.Errorf("unexpected exact sum: got %v want 1", got)
+	}
+	if got, _ := e.AbsRe.Float64(); got != 1+0x1p61 {
+		t.Errorf("unexpected absolute sum: got %v want %v", got, 1+0x1p61)
+	}
+	if e.Terms != 3 {
+		t.Errorf("unexpected number of terms: got %d want 3", e.Terms)
+	}
+	if err := CompareExact(x[0]+x[1]+x[2], e, Accuracy{Cond: 1}); err != nil {
+		t.Errorf("unexpected error for sum within bound: %v", err)
+	}
+	if err := CompareExact(x[0]+x[1]+x[2], e, Accuracy{}); err == nil {
+		t.Errorf("expected error for incorrectly rounded sum")
+	}
+
+	a := []complex128{complex(1, 2), complex(3, -1)}
+	b := []complex128{complex(-2, 1), complex(0.5, 4)}
+	d := ExactDot(a, b)
+	want := a[0]*b[0] + a[1]*b[1]
+	re, _ := d.Re.Float64()
+	im, _ := d.Im.Float64()
+	if complex(re, im) != want {
+		t.Errorf("unexpected exact dot: got %v want %v", complex(re, im), want)
+	}
+	if d.Terms != 4 {
+		t.Errorf("unexpected number of terms: got %d want 4", d.Terms)
+	}
+	if err := CompareExact(want, d, Accuracy{}); err != nil {
+		t.Errorf("unexpected error for exact dot: %v", err)
+	}
+	if err := CompareExact(complex(real(want), math.Nextafter(imag(want), 0)), d, Accuracy{ULPs: 1}); err != nil {
+		t.Errorf("unexpected error for dot within 1 ulp: %v", err)
+	}
+	if err := CompareExact(complex(real(want), math.NaN()), d, Accuracy{Cond: 1}); err == nil {
+		t.Errorf("expected error for NaN imaginary part")
+	}
+
+	p := ExactProd([]float32{2, -3, 0.5})
+	if got, _ := p.Re.Float64(); got != -3 || p.AbsRe.Sign() <= 0 {
+		t.Errorf("unexpected exact product: got %v", got)
+	}
+	n := ExactNorm([]float32{3, 4})
+	if got, _ := n.Re.Float64(); got != 5 {
+		t.Errorf("unexpected exact norm: got %v want 5", got)
+	}
+}
+
+func TestCheckAccuracy(t *testing.T) {
+	sum := func(x []float64) float64 {
+		var s float64
+		for _, v := range x {
+			s += v
+		}
+		return s
+	}
+	var calls int
+	CheckAccuracy(t, "sum", AccuracyIncs, Accuracy{Cond: 1}, func(rnd *rand.Rand, n, inc int, acc Accuracy) error {
+		calls++
+		x := AccuracyVector[float64](rnd, n, inc)
+		return CompareExact(sum(Elems(x, n, inc)), ExactSum(Elems(x, n, inc)), acc)
+	})
+	if want := len(AccuracyIncs) * len(accuracyLens) * accuracyTrials; calls != want {
+		t.Errorf("unexpected number of calls: got %d want %d", calls, want)
+	}
+
+	for _, test := range []struct {
+		name string
+		sum  func(x []float64, n, inc int) float64
+	}{
+		{
+			// Accumulating in float32 exceeds the float64 bound.
+			name: "float32",
+			sum: func(x []float64, n, inc int) float64 {
+				var s float32
+				for _, v := range Elems(x, n, inc) {
+					s += float32(v)
+				}
+				return float64(s)
+			},
+		},
+		{
+			// Reading the slice densely adds the guards.
+			name: "dense",
+			sum: func(x []float64, n, inc int) float64 {
+				return sum(x)
+			},
+		},
+	} {
+		r := &recorder{TB: t}
+		CheckAccuracy(r, test.name, AccuracyIncs, Accuracy{Cond: 1}, func(rnd *rand.Rand, n, inc int, acc Accuracy) error {
+			x := AccuracyVector[float64](rnd, n, inc)
+			return CompareExact(test.sum(x, n, inc), ExactSum(Elems(x, n, inc)), acc)
+		})
+		if !r.failed {
+			t.Errorf("%s: inaccurate kernel not reported", test.name)
+		}
+	}
+}
@@ -0,0 +1,311 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18
+// +build go1.18
+
+// Package asmtest provides test support shared by the c64, c128, f32 and
+// f64 kernel packages. The f16 and i32 packages use only its benchmarks.
+//
+// Its central type is Vector, a strided vector held in a buffer between
+// guard elements. A kernel is handed the slice returned by Vector.Slice,
+// and after it returns CheckGuards reports any write outside the vector's
+// elements while CheckVector also compares the elements with a reference.
+// The comparison helpers are NaN aware, and ULPs and WithinULP measure
+// the distance between results in units in the last place.
+//
+// The accuracy of reductions is checked against exact results formed
+// with math/big. CompareExact reports a result that is outside the error
+// bound documented for its kernel, given as an Accuracy, and
+// CheckAccuracy drives a kernel over random vectors of a range of
+// lengths and increments.
+//
+// Bench benchmarks a kernel over a range of lengths and increments and
+// reports its throughput in GFLOP/s and GB/s from the Cost per element.
+//
+// The package requires Go 1.18 or later.
+package asmtest
+
+import (
+	"math"
+	"testing"
+)
+
+// Elem is the set of element types handled by the package.
+type Elem interface {
+	float32 | float64 | complex64 | complex128
+}
+
+const (
+	guard32 = 0x7fadbeef
+	guard64 = 0x7ff4deadbeefcafe
+)
+
+// Guard returns the value held by the guard elements of a Vector. It is
+// a signaling NaN with a distinctive payload, which no kernel produces
+// from arithmetic, so any write to a guard changes its representation.
+func Guard[T Elem]() T {
+	var g T
+	switch p := any(&g).(type) {
+	case *float32:
+		*p = math.Float32frombits(guard32)
+	case *float64:
+		*p = math.Float64frombits(guard64)
+	case *complex64:
+		*p = complex(math.Float32frombits(guard32), math.Float32frombits(guard32))
+	case *complex128:
+		*p = complex(math.Float64frombits(guard64), math.Float64frombits(guard64))
+	}
+	return g
+}
+
+// Vector is a strided vector of N elements with increment Inc stored in
+// Buf after Front guard elements and before Back guard elements. The
+// elements of Buf between the vector's elements are also guards.
+type Vector[T Elem] struct {
+	Buf   []T
+	Front int
+	Back  int
+	Inc   int
+	N     int
+}
+
+// NewVector returns a Vector holding data with the absolute value of inc
+// as its increment and front and back guard elements.
+func NewVector[T Elem](data []T, inc, front, back int) *Vector[T] {
+	if inc < 0 {
+		inc = -inc
+	}
+	if inc == 0 {
+		panic("asmtest: zero increment")
+	}
+	var span int
+	if len(data) > 0 {
+		span = (len(data)-1)*inc + 1
+	}
+	v := &Vector[T]{
+		Buf:   make([]T, front+span+back),
+		Front: front,
+		Back:  back,
+		Inc:   inc,
+		N:     len(data),
+	}
+	g := Guard[T]()
+	for i := range v.Buf {
+		v.Buf[i] = g
+	}
+	for i, d := range data {
+		v.Buf[front+i*inc] = d
+	}
+	return v
+}
+
+// Slice returns the part of v's buffer that is passed to kernels. It
+// starts at the first element and ends after the last.
+func (v *Vector[T]) Slice() []T {
+	return v.Buf[v.Front : len(v.Buf)-v.Back]
+}
+
+// Data returns a dense copy of the elements of v.
+func (v *Vector[T]) Data() []T {
+	d := make([]T, v.N)
+	for i := range d {
+		d[i] = v.Buf[v.Front+i*v.Inc]
+	}
+	return d
+}
+
+// Clone returns a copy of v that does not share its buffer.
+func (v *Vector[T]) Clone() *Vector[T] {
+	c := *v
+	c.Buf = append([]T(nil), v.Buf...)
+	return &c
+}
+
+// IsElem returns whether Buf[i] is an element of v rather than a guard.
+func (v *Vector[T]) IsElem(i int) bool {
+	i -= v.Front
+	return 0 <= i && i < v.N*v.Inc && i%v.Inc == 0
+}
+
+// CheckGuards reports an error through tb if any guard element of v
+// has been modified, and returns whether all are intact. Only the first
+// modified guard is reported.
+func CheckGuards[T Elem](tb testing.TB, name string, v *Vector[T]) bool {
+	tb.Helper()
+	g := Guard[T]()
+	for i, b := range v.Buf {
+		if !v.IsElem(i) && !SameBits(b, g) {
+			tb.Errorf("%s: %s modified at buffer index %d: got %v", name, v.guardKind(i), i, b)
+			return false
+		}
+	}
+	return true
+}
+
+func (v *Vector[T]) guardKind(i int) string {
+	switch {
+	case i < v.Front:
+		return "front guard"
+	case i >= len(v.Buf)-v.Back:
+		return "back guard"
+	}
+	return "interior guard"
+}
+
+// CheckVector reports an error through tb if got, a vector passed to a
+// kernel, differs from want, the same vector passed to its reference.
+// Elements must be equal as defined by Same and guards must be intact.
+// It returns whether the vectors match. Only the first difference is
+// reported.
+func CheckVector[T Elem](tb testing.TB, name string, got, want *Vector[T]) bool {
+	tb.Helper()
+	if !CheckGuards(tb, name, got) {
+		return false
+	}
+	for i := 0; i < got.N; i++ {
+		g, w := got.Buf[got.Front+i*got.Inc], want.Buf[want.Front+i*want.Inc]
+		if !Same(g, w) {
+			tb.Errorf("%s: unexpected value at element %d: got %v want %v", name, i, g, w)
+			return false
+		}
+	}
+	return true
+}
+
+// Start returns the index of the first element visited by a kernel
+// walking n elements with increment inc.
+func Start(n, inc int) int {
+	if inc < 0 && n > 0 {
+		return (n - 1) * -inc
+	}
+	return 0
+}
+
+// EqualStrided returns whether the strided vector x holds the elements of
+// the dense vector ref at indices i*|inc|, as defined by Same.
+func EqualStrided[T Elem](ref, x []T, inc int) bool {
+	if inc < 0 {
+		inc = -inc
+	}
+	for i, v := range ref {
+		if !Same(x[i*inc], v) {
+			return false
+		}
+	}
+	return true
+}
+
+// SameBits returns whether a and b have the same representation.
+func SameBits[T Elem](a, b T) bool {
+	switch a := any(a).(type) {
+	case float32:
+		return math.Float32bits(a) == math.Float32bits(any(b).(float32))
+	case float64:
+		return math.Float64bits(a) == math.Float64bits(any(b).(float64))
+	case complex64:
+		b := any(b).(complex64)
+		return math.Float32bits(real(a)) == math.Float32bits(real(b)) &&
+			math.Float32bits(imag(a)) == math.Float32bits(imag(b))
+	case complex128:
+		b := any(b).(complex128)
+		return math.Float64bits(real(a)) == math.Float64bits(real(b)) &&
+			math.Float64bits(imag(a)) == math.Float64bits(imag(b))
+	}
+	panic("unreachable")
+}
+
+// Same returns whether a and b have the same representation, treating
+// all NaNs as equal. Unlike ==, it distinguishes zeros of opposite sign.
+func Same[T Elem](a, b T) bool {
+	same := func(a, b float64) bool {
+		return math.IsNaN(a) && math.IsNaN(b) || math.Float64bits(a) == math.Float64bits(b)
+	}
+	switch a := any(a).(type) {
+	case float32:
+		return same(float64(a), float64(any(b).(float32)))
+	case float64:
+		return same(a, any(b).(float64))
+	case complex64:
+		b := any(b).(complex64)
+		return same(float64(real(a)), float64(real(b))) && same(float64(imag(a)), float64(imag(b)))
+	case complex128:
+		b := any(b).(complex128)
+		return same(real(a), real(b)) && same(imag(a), imag(b))
+	}
+	panic("unreachable")
+}
+
+// ULPs returns the number of representable values of a's precision
+// between a and b, taking zeros of opposite sign as equal. For complex
+// types it returns the larger of the distances between the real and the
+// imaginary parts. The distance between a NaN and any other value,
+// including another NaN, is math.MaxUint64.
+func ULPs[T Elem](a, b T) uint64 {
+	switch a := any(a).(type) {
+	case float32:
+		return ulps32(a, any(b).(float32))
+	case float64:
+		return ulps64(a, any(b).(float64))
+	case complex64:
+		b := any(b).(complex64)
+		return maxULPs(ulps32(real(a), real(b)), ulps32(imag(a), imag(b)))
+	case complex128:
+		b := any(b).(complex128)
+		return maxULPs(ulps64(real(a), real(b)), ulps64(imag(a), imag(b)))
+	}
+	panic("unreachable")
+}
+
+// WithinULP returns whether a and b are equal as defined by Same or are
+// at most n units in the last place apart.
+func WithinULP[T Elem](a, b T, n uint64) bool {
+	return Same(a, b) || ULPs(a, b) <= n
+}
+
+func ulps32(a, b float32) uint64 {
+	if a != a || b != b {
+		return math.MaxUint64
+	}
+	return dist(ordinal32(a), ordinal32(b))
+}
+
+func ulps64(a, b float64) uint64 {
+	if a != a || b != b {
+		return math.MaxUint64
+	}
+	return dist(ordinal64(a), ordinal64(b))
+}
+
+// ordinal32 and ordinal64 map floating point values to integers such
+// that adjacent values map to adjacent integers and both zeros map to 0.
+func ordinal32(f float32) int64 {
+	b := int64(math.Float32bits(f) &^ (1 << 31))
+	if math.Signbit(float64(f)) {
+		return -b
+	}
+	return b
+}
+
+func ordinal64(f float64) int64 {
+	b := int64(math.Float64bits(f) &^ (1 << 63))
+	if math.Signbit(f) {
+		return -b
+	}
+	return b
+}
+
+func maxULPs(a, b uint64) uint64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func dist(a, b int64) uint64 {
+	if a < b {
+		return uint64(b) - uint64(a)
+	}
+	return uint64(a) - uint64(b)
+}
@@ -0,0 +1,148 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18
+// +build go1.18
+
+package asmtest
+
+import (
+	"math"
+	"testing"
+)
+
+// recorder is a testing.TB that records whether an error was reported.
+type recorder struct {
+	testing.TB
+	failed bool
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(string, ...interface{}) { r.failed = true }
+
+func TestVector(t *testing.T) {
+	for _, inc := range []int{1, 2, -3} {
+		data := []float64{1, 2, 3}
+		v := NewVector(data, inc, 2, 3)
+		abs := inc
+		if abs < 0 {
+			abs = -abs
+		}
+		if len(v.Slice()) != 2*abs+1 {
+			t.Errorf("inc=%d: unexpected slice length: got %d want %d", inc, len(v.Slice()), 2*abs+1)
+		}
+		if !EqualStrided(data, v.Slice(), inc) {
+			t.Errorf("inc=%d: unexpected elements: got %v want %v", inc, v.Slice(), data)
+		}
+		d := v.Data()
+		if !EqualStrided(data, d, 1) {
+			t.Errorf("inc=%d: unexpected data: got %v want %v", inc, d, data)
+		}
+		var elems int
+		for i := range v.Buf {
+			if v.IsElem(i) {
+				elems++
+			} else if !SameBits(v.Buf[i], Guard[float64]()) {
+				t.Errorf("inc=%d: guard missing at buffer index %d", inc, i)
+			}
+		}
+		if elems != len(data) {
+			t.Errorf("inc=%d: unexpected element count: got %d want %d", inc, elems, len(data))
+		}
+
+		r := &recorder{TB: t}
+		if !CheckGuards(r, "v", v) || r.failed {
+			t.Errorf("inc=%d: unexpected guard error for unmodified vector", inc)
+		}
+		c := v.Clone()
+		c.Buf[c.Front] = 5
+		if v.Buf[v.Front] != 1 {
+			t.Errorf("inc=%d: clone shares buffer", inc)
+		}
+		r = &recorder{TB: t}
+		if CheckVector(r, "v", c, v) || !r.failed {
+			t.Errorf("inc=%d: modified element not reported", inc)
+		}
+		guards := []int{0, v.Front - 1, len(v.Buf) - 1}
+		if abs > 1 {
+			guards = append(guards, v.Front+1)
+		}
+		for _, i := range guards {
+			c := v.Clone()
+			c.Buf[i] = math.NaN()
+			r = &recorder{TB: t}
+			if CheckGuards(r, "v", c) || !r.failed {
+				t.Errorf("inc=%d: modified guard at buffer index %d not reported", inc, i)
+			}
+		}
+	}
+}
+
+func TestSame(t *testing.T) {
+	nan, negZero := math.NaN(), math.Copysign(0, -1)
+	for _, test := range []struct {
+		a, b     float64
+		same     bool
+		sameBits bool
+	}{
+		{a: 1, b: 1, same: true, sameBits: true},
+		{a: 1, b: 2},
+		{a: nan, b: nan, same: true, sameBits: true},
+		{a: nan, b: math.Float64frombits(guard64), same: true},
+		{a: 0, b: negZero},
+		{a: math.Inf(1), b: math.Inf(1), same: true, sameBits: true},
+	} {
+		if got := Same(test.a, test.b); got != test.same {
+			t.Errorf("unexpected Same(%v, %v): got %t", test.a, test.b, got)
+		}
+		if got := SameBits(test.a, test.b); got != test.sameBits {
+			t.Errorf("unexpected SameBits(%v, %v): got %t", test.a, test.b, got)
+		}
+		if got := Same(complex(1, test.a), complex(1, test.b)); got != test.same {
+			t.Errorf("unexpected Same(1+%vi, 1+%vi): got %t", test.a, test.b, got)
+		}
+		if got := Same(float32(test.a), float32(test.b)); got != test.same {
+			t.Errorf("unexpected Same(float32(%v), float32(%v)): got %t", test.a, test.b, got)
+		}
+	}
+}
+
+func TestULPs(t *testing.T) {
+	one32 := float32(1)
+	for _, test := range []struct {
+		a, b float64
+		want uint64
+	}{
+		{a: 1, b: 1, want: 0},
+		{a: 1, b: math.Nextafter(1, 2), want: 1},
+		{a: 1, b: math.Nextafter(1, 0), want: 1},
+		{a: 0, b: math.Copysign(0, -1), want: 0},
+		{a: math.SmallestNonzeroFloat64, b: -math.SmallestNonzeroFloat64, want: 2},
+		{a: math.MaxFloat64, b: math.Inf(1), want: 1},
+		{a: math.NaN(), b: 1, want: math.MaxUint64},
+		{a: math.NaN(), b: math.NaN(), want: math.MaxUint64},
+	} {
+		if got := ULPs(test.a, test.b); got != test.want {
+			t.Errorf("unexpected ULPs(%v, %v): got %d want %d", test.a, test.b, got, test.want)
+		}
+		if got := ULPs(test.b, test.a); got != test.want {
+			t.Errorf("unexpected ULPs(%v, %v): got %d want %d", test.b, test.a, got, test.want)
+		}
+	}
+	if got := ULPs(one32, math.Nextafter32(math.Nextafter32(one32, 2), 2)); got != 2 {
+		t.Errorf("unexpected float32 ULPs: got %d want 2", got)
+	}
+	a := complex(1, 1)
+	b := complex(math.Nextafter(1, 2), math.Nextafter(math.Nextafter(1, 0), 0))
+	if got := ULPs(a, b); got != 2 {
+		t.Errorf("unexpected complex128 ULPs: got %d want 2", got)
+	}
+	if !WithinULP(a, b, 2) || WithinULP(a, b, 1) {
+		t.Errorf("unexpected WithinULP result for %v and %v", a, b)
+	}
+	if !WithinULP(complex64(complex(float32(math.NaN()), 0)), complex(float32(math.NaN()), 0), 0) {
+		t.Errorf("NaN not within 0 ULP of NaN")
+	}
+}
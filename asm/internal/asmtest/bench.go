@@ -0,0 +1,77 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18
+// +build go1.18
+
+package asmtest
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+// BenchLens are the vector lengths used by Bench. They range from lengths
+// dominated by the cost of the call to lengths that exceed the caches.
+var BenchLens = []int{1, 2, 3, 4, 5, 10, 100, 1000, 10000, 100000}
+
+// The increments passed to Bench for strided kernels that accept negative
+// increments, for those that do not, and for unitary kernels.
+var (
+	BenchIncs        = []int{1, 2, 4, -2}
+	BenchPosIncs     = []int{1, 2, 4}
+	BenchUnitaryIncs = []int{1}
+)
+
+// Cost is the work a kernel does for each element of its vectors.
+type Cost struct {
+	// Flops is the number of arithmetic operations.
+	Flops float64
+	// Bytes is the number of bytes of vector elements read and written.
+	Bytes float64
+}
+
+// BenchVector returns a vector of n elements with increment inc for a
+// benchmark. All of its elements are one.
+func BenchVector[T Elem](n, inc int) []T {
+	if inc < 0 {
+		inc = -inc
+	}
+	var span int
+	if n > 0 {
+		span = (n-1)*inc + 1
+	}
+	x := make([]T, span)
+	for i := range x {
+		x[i] = 1
+	}
+	return x
+}
+
+// Bench benchmarks a kernel in sub-benchmarks of b named n=<n>/inc=<inc>
+// for each length in BenchLens and each of incs. setup allocates the
+// kernel's vectors for the length n and increment inc and returns a
+// function that makes one call, which is the only part timed. Each
+// sub-benchmark reports the rates of the cost per element as GFLOP/s,
+// omitted if the kernel does no arithmetic, and GB/s.
+func Bench(b *testing.B, incs []int, cost Cost, setup func(n, inc int) func()) {
+	for _, inc := range incs {
+		for _, n := range BenchLens {
+			b.Run(fmt.Sprintf("n=%d/inc=%d", n, inc), func(b *testing.B) {
+				call := setup(n, inc)
+				b.ResetTimer()
+				start := time.Now()
+				for i := 0; i < b.N; i++ {
+					call()
+				}
+				elems := float64(n) * float64(b.N) / time.Since(start).Seconds() / 1e9
+				if cost.Flops != 0 {
+					b.ReportMetric(cost.Flops*elems, "GFLOP/s")
+				}
+				b.ReportMetric(cost.Bytes*elems, "GB/s")
+			})
+		}
+	}
+}
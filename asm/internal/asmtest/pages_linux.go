@@ -0,0 +1,164 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18 && linux
+// +build go1.18,linux
+
+package asmtest
+
+import (
+	"fmt"
+	"runtime/debug"
+	"syscall"
+	"testing"
+	"unsafe"
+)
+
+// MaxPageLen is the largest vector length used by CheckPages.
+const MaxPageLen = 64
+
+// maxPageInc is the largest absolute increment accepted by CheckPages.
+const maxPageInc = 8
+
+// The increments passed to CheckPages for strided kernels that accept
+// negative increments, for those that do not, and for unitary kernels.
+var (
+	PageIncs        = []int{1, 2, 3, 5, -1, -2, -5}
+	PagePosIncs     = []int{1, 2, 3, 5}
+	PageUnitaryIncs = []int{1}
+)
+
+// page is a region of accessible memory mapped between two pages that
+// have no access permissions.
+type page struct {
+	mem  []byte // mem is the whole mapping.
+	data []byte // data is the accessible part of mem.
+}
+
+func newPage(size int) (*page, error) {
+	ps := syscall.Getpagesize()
+	size = (size + ps - 1) / ps * ps
+	mem, err := syscall.Mmap(-1, 0, size+2*ps, syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_ANON|syscall.MAP_PRIVATE)
+	if err != nil {
+		return nil, err
+	}
+	p := &page{mem: mem, data: mem[ps : ps+size]}
+	for _, g := range [][]byte{mem[:ps], mem[ps+size:]} {
+		err = syscall.Mprotect(g, syscall.PROT_NONE)
+		if err != nil {
+			p.free()
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+func (p *page) free() error {
+	return syscall.Munmap(p.mem)
+}
+
+// Pages allocates vectors that lie flush against an inaccessible page,
+// so that a kernel reading or writing outside a vector faults.
+type Pages struct {
+	pages []*page
+	next  int
+	end   bool
+}
+
+// PageVector returns a vector of n elements with increment inc allocated
+// from p. The vector ends at an inaccessible page if p is placing vectors
+// at the end of accessible memory, and otherwise starts after one. Its
+// elements are one and the elements between them are zero. The vector
+// is valid until the kernel call for which it was allocated returns.
+func PageVector[T Elem](p *Pages, n, inc int) []T {
+	if inc < 0 {
+		inc = -inc
+	}
+	if n > MaxPageLen || inc > maxPageInc {
+		panic("asmtest: page vector too long")
+	}
+	var span int
+	if n > 0 {
+		span = (n-1)*inc + 1
+	}
+	var zero T
+	size := int(unsafe.Sizeof(zero))
+	if p.next == len(p.pages) {
+		pg, err := newPage(MaxPageLen * maxPageInc * 16)
+		if err != nil {
+			panic(fmt.Sprintf("asmtest: cannot map page: %v", err))
+		}
+		p.pages = append(p.pages, pg)
+	}
+	data := p.pages[p.next].data
+	p.next++
+	off := 0
+	if p.end {
+		off = len(data) - span*size
+	}
+	// Form the slice from the address of data[off] so that a zero
+	// length vector still points at the boundary.
+	v := unsafe.Slice((*T)(unsafe.Add(unsafe.Pointer(&data[0]), off)), span)
+	for i := range v {
+		v[i] = 0
+	}
+	for i := 0; i < n; i++ {
+		v[i*inc] = 1
+	}
+	return v
+}
+
+// CheckPages calls kernel with each length in [0, MaxPageLen] and each
+// of incs, once with the vectors it allocates from p with PageVector
+// placed at the end of accessible memory and once with them placed at
+// the start. Any access outside a vector faults, and the fault is
+// reported as an error through tb. Kernels without an increment should
+// be checked with PageUnitaryIncs.
+func CheckPages(tb testing.TB, name string, incs []int, kernel func(p *Pages, n, inc int)) {
+	tb.Helper()
+	p := &Pages{}
+	defer func() {
+		for _, pg := range p.pages {
+			pg.free()
+		}
+	}()
+	defer debug.SetPanicOnFault(debug.SetPanicOnFault(true))
+	for _, end := range []bool{true, false} {
+		place := "start"
+		if end {
+			place = "end"
+		}
+		for _, inc := range incs {
+			for n := 0; n <= MaxPageLen; n++ {
+				p.next = 0
+				p.end = end
+				if err := callPages(p, n, inc, kernel); err != nil {
+					tb.Errorf("%s: n=%d inc=%d at %s of page: %v", name, n, inc, place, err)
+					return
+				}
+			}
+		}
+	}
+}
+
+func callPages(p *Pages, n, inc int, kernel func(p *Pages, n, inc int)) (err error) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+		if f, ok := r.(interface{ Addr() uintptr }); ok {
+			for i, pg := range p.pages[:p.next] {
+				lo := uintptr(unsafe.Pointer(&pg.mem[0]))
+				if lo <= f.Addr() && f.Addr() < lo+uintptr(len(pg.mem)) {
+					err = fmt.Errorf("access outside vector %d at %#x", i, f.Addr())
+					return
+				}
+			}
+		}
+		panic(r)
+	}()
+	kernel(p, n, inc)
+	return nil
+}
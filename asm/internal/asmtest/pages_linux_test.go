@@ -0,0 +1,60 @@
+// Copyright ©2017 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18 && linux
+// +build go1.18,linux
+
+package asmtest
+
+import (
+	"testing"
+	"unsafe"
+)
+
+var sink float64
+
+func TestCheckPages(t *testing.T) {
+	var calls int
+	CheckPages(t, "valid", PageIncs, func(p *Pages, n, inc int) {
+		calls++
+		x := PageVector[complex128](p, n, inc)
+		y := PageVector[float32](p, n, inc)
+		abs := inc
+		if abs < 0 {
+			abs = -abs
+		}
+		for i := 0; i < n; i++ {
+			if x[i*abs] != 1 || y[i*abs] != 1 {
+				t.Fatalf("n=%d inc=%d: unexpected element %d", n, inc, i)
+			}
+		}
+	})
+	if want := 2 * len(PageIncs) * (MaxPageLen + 1); calls != want {
+		t.Errorf("unexpected number of calls: got %d want %d", calls, want)
+	}
+
+	for _, test := range []struct {
+		name string
+		past bool
+	}{
+		{name: "overread", past: true},
+		{name: "underread", past: false},
+	} {
+		r := &recorder{TB: t}
+		CheckPages(r, test.name, PageUnitaryIncs, func(p *Pages, n, _ int) {
+			x := PageVector[float64](p, n, 1)
+			if n == 0 {
+				return
+			}
+			i := -1
+			if test.past {
+				i = n
+			}
+			sink = *(*float64)(unsafe.Add(unsafe.Pointer(&x[0]), i*8))
+		})
+		if !r.failed {
+			t.Errorf("%s not reported", test.name)
+		}
+	}
+}
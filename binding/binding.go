@@ -16,132 +16,339 @@ import (
 	"go/token"
 	"sort"
 	"text/template"
-	"unsafe"
 
 	"github.com/cznic/cc"
 	"github.com/cznic/xc"
 )
 
-func model() *cc.Model {
-	p := int(unsafe.Sizeof(uintptr(0)))
-	i := int(unsafe.Sizeof(int(0)))
-	return &cc.Model{
-		Items: map[cc.Kind]cc.ModelItem{
-			cc.Ptr:               {Size: p, Align: p, StructAlign: p},
-			cc.UintPtr:           {Size: p, Align: p, StructAlign: p},
-			cc.Void:              {Size: 0, Align: 1, StructAlign: 1},
-			cc.Char:              {Size: 1, Align: 1, StructAlign: 1},
-			cc.SChar:             {Size: 1, Align: 1, StructAlign: 1},
-			cc.UChar:             {Size: 1, Align: 1, StructAlign: 1},
-			cc.Short:             {Size: 2, Align: 2, StructAlign: 2},
-			cc.UShort:            {Size: 2, Align: 2, StructAlign: 2},
-			cc.Int:               {Size: 4, Align: 4, StructAlign: 4},
-			cc.UInt:              {Size: 4, Align: 4, StructAlign: 4},
-			cc.Long:              {Size: i, Align: i, StructAlign: i},
-			cc.ULong:             {Size: i, Align: i, StructAlign: i},
-			cc.LongLong:          {Size: 8, Align: 8, StructAlign: 8},
-			cc.ULongLong:         {Size: 8, Align: 8, StructAlign: 8},
-			cc.Float:             {Size: 4, Align: 4, StructAlign: 4},
-			cc.Double:            {Size: 8, Align: 8, StructAlign: 8},
-			cc.LongDouble:        {Size: 8, Align: 8, StructAlign: 8},
-			cc.Bool:              {Size: 1, Align: 1, StructAlign: 1},
-			cc.FloatComplex:      {Size: 8, Align: 8, StructAlign: 8},
-			cc.DoubleComplex:     {Size: 16, Align: 16, StructAlign: 16},
-			cc.LongDoubleComplex: {Size: 16, Align: 16, StructAlign: 16},
-		},
-	}
-}
-
-// TypeKey is a terse C type description.
+// TypeKey is a terse C type description. For a pointer type, Kind, Name
+// and IsConst describe the pointee.
+//
+// A TypeKey with a Name is matched by typedef name, and its Kind should
+// be left as cc.Undefined. Type mappings are searched first for the
+// typedef name of a type with its const qualification and without, and
+// then for its kind with its const qualification and without. All the
+// keys are searched in the user-provided mappings before any is searched
+// in the defaults.
 type TypeKey struct {
 	IsPointer bool
 	Kind      cc.Kind
+
+	// Name is the typedef name the type was declared with.
+	Name string
+	// IsConst is whether the type is const qualified.
+	IsConst bool
+}
+
+// TypeKeyFor returns the complete TypeKey for typ. The typedef name and the
+// const qualification are those written in the declaration that defined an
+// entity of typ, such as a function parameter or result or a struct field.
+// A typedef name that names a pointer type is not a Name of the pointee, so
+// such types are matched by kind.
+func TypeKeyFor(typ cc.Type) TypeKey {
+	k := TypeKey{Kind: typ.Kind()}
+	elem := typ
+	if k.Kind == cc.Ptr {
+		elem = typ.Element()
+		k.IsPointer = true
+		k.Kind = elem.Kind()
+	}
+
+	d := typ.Declarator()
+	ptrs := pointers(d)
+	if d == nil || len(ptrs) != pointerDepth(typ) {
+		// The type is not the one written in the declaration,
+		// or is a typedef of a pointer type.
+		k.IsConst = k.Kind != cc.Ptr && elem.Specifier().IsConst()
+		return k
+	}
+	if len(ptrs) < 2 {
+		s := d.RawSpecifier()
+		k.IsConst = s.IsConst()
+		if id := s.TypedefName(); id != 0 {
+			k.Name = string(xc.Dict.S(id))
+		}
+		return k
+	}
+	// The pointee is the pointer declared by the last but one '*'.
+	k.IsConst = isConst(ptrs[len(ptrs)-2])
+	return k
+}
+
+// pointers returns the pointer declarators of d from left to right.
+func pointers(d *cc.Declarator) []*cc.Pointer {
+	if d == nil || d.PointerOpt == nil {
+		return nil
+	}
+	var ptrs []*cc.Pointer
+	for p := d.PointerOpt.Pointer; p != nil; p = p.Pointer {
+		ptrs = append(ptrs, p)
+	}
+	return ptrs
+}
+
+// pointerDepth returns the number of pointer levels of typ.
+func pointerDepth(typ cc.Type) int {
+	var n int
+	for ; typ.Kind() == cc.Ptr; typ = typ.Element() {
+		n++
+	}
+	return n
+}
+
+// isConst returns whether the pointer declared by p is const qualified.
+func isConst(p *cc.Pointer) bool {
+	if p.TypeQualifierListOpt == nil {
+		return false
+	}
+	for l := p.TypeQualifierListOpt.TypeQualifierList; l != nil; l = l.TypeQualifierList {
+		if l.TypeQualifier != nil && l.TypeQualifier.Case == 0 /* "const" */ {
+			return true
+		}
+	}
+	return false
+}
+
+// lookup returns the first template found for the keys matching k in the
+// search order described by TypeKey. All the keys are searched in the user
+// maps before any is searched in the defaults maps.
+func lookup(k TypeKey, user []map[TypeKey]*template.Template, defaults ...map[TypeKey]*template.Template) (*template.Template, bool) {
+	var keys []TypeKey
+	if k.Name != "" {
+		keys = append(keys,
+			TypeKey{IsPointer: k.IsPointer, Name: k.Name, IsConst: k.IsConst},
+			TypeKey{IsPointer: k.IsPointer, Name: k.Name},
+		)
+	}
+	keys = append(keys,
+		TypeKey{IsPointer: k.IsPointer, Kind: k.Kind, IsConst: k.IsConst},
+		TypeKey{IsPointer: k.IsPointer, Kind: k.Kind},
+	)
+	for _, maps := range [][]map[TypeKey]*template.Template{user, defaults} {
+		for _, key := range keys {
+			for _, t := range maps {
+				if s, ok := t[key]; ok {
+					return s, true
+				}
+			}
+		}
+	}
+	return nil, false
 }
 
 var goTypes = map[TypeKey]*template.Template{
-	{Kind: cc.Undefined}:               template.Must(template.New("<undefined>").Parse("<undefined>")),
-	{Kind: cc.Int}:                     template.Must(template.New("int").Parse("int")),
-	{Kind: cc.Float}:                   template.Must(template.New("float32").Parse("float32")),
-	{Kind: cc.Float, IsPointer: true}:  template.Must(template.New("[]float32").Parse("[]float32")),
-	{Kind: cc.Double}:                  template.Must(template.New("float64").Parse("float64")),
-	{Kind: cc.Double, IsPointer: true}: template.Must(template.New("[]float64").Parse("[]float64")),
-	{Kind: cc.Bool}:                    template.Must(template.New("bool").Parse("bool")),
-	{Kind: cc.FloatComplex}:            template.Must(template.New("complex64").Parse("complex64")),
-	{Kind: cc.DoubleComplex}:           template.Must(template.New("complex128").Parse("complex128")),
+	{Kind: cc.Undefined}:                            template.Must(template.New("<undefined>").Parse("<undefined>")),
+	{Kind: cc.Char}:                                 template.Must(template.New("byte").Parse("byte")),
+	{Kind: cc.Char, IsPointer: true}:                template.Must(template.New("[]byte").Parse("[]byte")),
+	{Kind: cc.Char, IsPointer: true, IsConst: true}: template.Must(template.New("string").Parse("string")),
+	{Kind: cc.SChar}:                                template.Must(template.New("int8").Parse("int8")),
+	{Kind: cc.SChar, IsPointer: true}:               template.Must(template.New("[]int8").Parse("[]int8")),
+	{Kind: cc.UChar}:                                template.Must(template.New("byte").Parse("byte")),
+	{Kind: cc.UChar, IsPointer: true}:               template.Must(template.New("[]byte").Parse("[]byte")),
+	{Kind: cc.Short}:                                template.Must(template.New("int16").Parse("int16")),
+	{Kind: cc.Short, IsPointer: true}:               template.Must(template.New("[]int16").Parse("[]int16")),
+	{Kind: cc.UShort}:                               template.Must(template.New("uint16").Parse("uint16")),
+	{Kind: cc.UShort, IsPointer: true}:              template.Must(template.New("[]uint16").Parse("[]uint16")),
+	{Kind: cc.Int}:                                  template.Must(template.New("int").Parse("int")),
+	{Kind: cc.Int, IsPointer: true}:                 template.Must(template.New("[]int32").Parse("[]int32")),
+	{Kind: cc.UInt}:                                 template.Must(template.New("uint32").Parse("uint32")),
+	{Kind: cc.UInt, IsPointer: true}:                template.Must(template.New("[]uint32").Parse("[]uint32")),
+	{Kind: cc.LongLong}:                             template.Must(template.New("int64").Parse("int64")),
+	{Kind: cc.LongLong, IsPointer: true}:            template.Must(template.New("[]int64").Parse("[]int64")),
+	{Kind: cc.ULongLong}:                            template.Must(template.New("uint64").Parse("uint64")),
+	{Kind: cc.ULongLong, IsPointer: true}:           template.Must(template.New("[]uint64").Parse("[]uint64")),
+	{Kind: cc.Float}:                                template.Must(template.New("float32").Parse("float32")),
+	{Kind: cc.Float, IsPointer: true}:               template.Must(template.New("[]float32").Parse("[]float32")),
+	{Kind: cc.Double}:                               template.Must(template.New("float64").Parse("float64")),
+	{Kind: cc.Double, IsPointer: true}:              template.Must(template.New("[]float64").Parse("[]float64")),
+	{Kind: cc.Bool}:                                 template.Must(template.New("bool").Parse("bool")),
+	{Kind: cc.Bool, IsPointer: true}:                template.Must(template.New("[]bool").Parse("[]bool")),
+	{Kind: cc.FloatComplex}:                         template.Must(template.New("complex64").Parse("complex64")),
+	{Kind: cc.FloatComplex, IsPointer: true}:        template.Must(template.New("[]complex64").Parse("[]complex64")),
+	{Kind: cc.DoubleComplex}:                        template.Must(template.New("complex128").Parse("complex128")),
+	{Kind: cc.DoubleComplex, IsPointer: true}:       template.Must(template.New("[]complex128").Parse("[]complex128")),
+}
+
+// longGoTypes holds the default Go types of long and unsigned long, and of
+// pointers to them, by the size of long.
+var longGoTypes = map[int]map[TypeKey]*template.Template{
+	4: {
+		{Kind: cc.Long}:                   template.Must(template.New("int").Parse("int")),
+		{Kind: cc.Long, IsPointer: true}:  template.Must(template.New("[]int32").Parse("[]int32")),
+		{Kind: cc.ULong}:                  template.Must(template.New("uint32").Parse("uint32")),
+		{Kind: cc.ULong, IsPointer: true}: template.Must(template.New("[]uint32").Parse("[]uint32")),
+	},
+	8: {
+		{Kind: cc.Long}:                   template.Must(template.New("int64").Parse("int64")),
+		{Kind: cc.Long, IsPointer: true}:  template.Must(template.New("[]int64").Parse("[]int64")),
+		{Kind: cc.ULong}:                  template.Must(template.New("uint64").Parse("uint64")),
+		{Kind: cc.ULong, IsPointer: true}: template.Must(template.New("[]uint64").Parse("[]uint64")),
+	},
+}
+
+// longSize returns the size of typ, or of the type it points to, if it is
+// long or unsigned long, and zero otherwise.
+func longSize(typ cc.Type) int {
+	if typ.Kind() == cc.Ptr {
+		typ = typ.Element()
+	}
+	switch typ.Kind() {
+	case cc.Long, cc.ULong:
+		return typ.SizeOf()
+	}
+	return 0
 }
 
 // GoTypeFor returns a string representation of the given type using a mapping in
-// types. GoTypeFor will panic if no type mapping is found after searching the
-// user-provided types mappings and then the following mapping:
-//  {Kind: cc.Int}:                     "int",
-//  {Kind: cc.Float}:                   "float32",
-//  {Kind: cc.Float, IsPointer: true}:  "[]float32",
-//  {Kind: cc.Double}:                  "float64",
-//  {Kind: cc.Double, IsPointer: true}: "[]float64",
-//  {Kind: cc.Bool}:                    "bool",
-//  {Kind: cc.FloatComplex}:            "complex64",
-//  {Kind: cc.DoubleComplex}:           "complex128",
+// types. The user-provided types mappings are searched for each key matching the
+// type, in the order described by TypeKey, and then the following mapping is:
+//  {Kind: cc.Char}:                                 "byte",
+//  {Kind: cc.Char, IsPointer: true}:                "[]byte",
+//  {Kind: cc.Char, IsPointer: true, IsConst: true}: "string",
+//  {Kind: cc.SChar}:                                "int8",
+//  {Kind: cc.SChar, IsPointer: true}:               "[]int8",
+//  {Kind: cc.UChar}:                                "byte",
+//  {Kind: cc.UChar, IsPointer: true}:               "[]byte",
+//  {Kind: cc.Short}:                                "int16",
+//  {Kind: cc.Short, IsPointer: true}:               "[]int16",
+//  {Kind: cc.UShort}:                               "uint16",
+//  {Kind: cc.UShort, IsPointer: true}:              "[]uint16",
+//  {Kind: cc.Int}:                                  "int",
+//  {Kind: cc.Int, IsPointer: true}:                 "[]int32",
+//  {Kind: cc.UInt}:                                 "uint32",
+//  {Kind: cc.UInt, IsPointer: true}:                "[]uint32",
+//  {Kind: cc.Long}:                                 "int" or "int64",
+//  {Kind: cc.Long, IsPointer: true}:                "[]int32" or "[]int64",
+//  {Kind: cc.ULong}:                                "uint32" or "uint64",
+//  {Kind: cc.ULong, IsPointer: true}:               "[]uint32" or "[]uint64",
+//  {Kind: cc.LongLong}:                             "int64",
+//  {Kind: cc.LongLong, IsPointer: true}:            "[]int64",
+//  {Kind: cc.ULongLong}:                            "uint64",
+//  {Kind: cc.ULongLong, IsPointer: true}:           "[]uint64",
+//  {Kind: cc.Float}:                                "float32",
+//  {Kind: cc.Float, IsPointer: true}:               "[]float32",
+//  {Kind: cc.Double}:                               "float64",
+//  {Kind: cc.Double, IsPointer: true}:              "[]float64",
+//  {Kind: cc.Bool}:                                 "bool",
+//  {Kind: cc.Bool, IsPointer: true}:                "[]bool",
+//  {Kind: cc.FloatComplex}:                         "complex64",
+//  {Kind: cc.FloatComplex, IsPointer: true}:        "[]complex64",
+//  {Kind: cc.DoubleComplex}:                        "complex128",
+//  {Kind: cc.DoubleComplex, IsPointer: true}:       "[]complex128",
+// The Go types of long and unsigned long are those of the size of long in the data
+// model typ was parsed with.
+// A struct or union type with a tag or typedef name that is not mapped is given
+// its Struct GoName as its Go type name, as used by GoStructFor, and a pointer to
+// it is given the pointer to that type. GoTypeFor will panic if no type mapping is found or the
+// template fails; the Declaration method of the same name returns an error instead.
 func GoTypeFor(typ cc.Type, name string, types ...map[TypeKey]*template.Template) string {
+	s, err := goTypeFor(typ, name, types)
+	if err != nil {
+		panic(mapPanic(typ, err))
+	}
+	return s
+}
+
+func goTypeFor(typ cc.Type, name string, types []map[TypeKey]*template.Template) (string, error) {
 	if typ == nil {
-		return "<nil>"
+		return "<nil>", nil
 	}
-	k := typ.Kind()
-	isPtr := typ.Kind() == cc.Ptr
-	if isPtr {
-		k = typ.Element().Kind()
+	k := TypeKeyFor(typ)
+	if s, ok := lookup(k, types, longGoTypes[longSize(typ)], goTypes); ok {
+		return execute(s, name)
 	}
-	var buf bytes.Buffer
-	for _, t := range types {
-		if s, ok := t[TypeKey{Kind: k, IsPointer: isPtr}]; ok {
-			err := s.Execute(&buf, name)
-			if err != nil {
-				panic(err)
-			}
-			return buf.String()
+	if goName, _, ok := structNames(typ, k.IsPointer); ok {
+		// The Go type is defined by GoStructFor.
+		if k.IsPointer {
+			return "*" + goName, nil
 		}
+		return goName, nil
 	}
-	s, ok := goTypes[TypeKey{Kind: k, IsPointer: isPtr}]
-	if ok {
-		err := s.Execute(&buf, name)
-		if err != nil {
-			panic(err)
-		}
-		return buf.String()
+	return "", ErrNoMapping
+}
+
+// execute returns the result of executing s with the Go value name.
+func execute(s *template.Template, name string) (string, error) {
+	var buf bytes.Buffer
+	err := s.Execute(&buf, name)
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// mapPanic returns the value the panicking mapping functions panic with
+// when mapping typ fails with err.
+func mapPanic(typ cc.Type, err error) interface{} {
+	switch err {
+	case ErrNoMapping:
+		return fmt.Sprintf("unknown type key: %+v", TypeKeyFor(typ))
+	case ErrNotEnum:
+		return fmt.Sprintf("invalid type: %v", typ)
 	}
-	panic(fmt.Sprintf("unknown type key: %+v", TypeKey{Kind: k, IsPointer: isPtr}))
+	return err
 }
 
 // GoTypeForEnum returns a string representation of the given enum type using a mapping
 // in types. GoTypeForEnum will panic if no type mapping is found after searching the
-// user-provided types mappings or the type is not an enum.
+// user-provided types mappings, the template fails or the type is not an enum; the
+// Declaration method of the same name returns an error instead.
 func GoTypeForEnum(typ cc.Type, name string, types ...map[string]*template.Template) string {
+	s, err := enumFor(typ, name, types)
+	if err != nil {
+		panic(mapPanic(typ, err))
+	}
+	return s
+}
+
+// enumFor returns the result of executing the mapping in types for the
+// tag of the enum type typ with the Go value name.
+func enumFor(typ cc.Type, name string, types []map[string]*template.Template) (string, error) {
 	if typ == nil {
-		return "<nil>"
+		return "<nil>", nil
 	}
 	if typ.Kind() != cc.Enum {
-		panic(fmt.Sprintf("invalid type: %v", typ))
+		return "", ErrNotEnum
 	}
 	tag := typ.Tag()
 	if tag != 0 {
 		n := string(xc.Dict.S(tag))
 		for _, t := range types {
 			if s, ok := t[n]; ok {
-				var buf bytes.Buffer
-				err := s.Execute(&buf, name)
-				if err != nil {
-					panic(err)
-				}
-				return buf.String()
+				return execute(s, name)
 			}
 		}
 	}
-	panic(fmt.Sprintf("unknown type: %+v", typ))
+	return "", ErrNoMapping
 }
 
 var cgoTypes = map[TypeKey]*template.Template{
 	{Kind: cc.Void, IsPointer: true}: template.Must(template.New("void*").Parse("unsafe.Pointer(&{{.}}[0])")),
 
-	{Kind: cc.Int}: template.Must(template.New("int").Parse("C.int({{.}})")),
+	{Kind: cc.Char}:      template.Must(template.New("char").Parse("C.char({{.}})")),
+	{Kind: cc.SChar}:     template.Must(template.New("schar").Parse("C.schar({{.}})")),
+	{Kind: cc.UChar}:     template.Must(template.New("uchar").Parse("C.uchar({{.}})")),
+	{Kind: cc.Short}:     template.Must(template.New("short").Parse("C.short({{.}})")),
+	{Kind: cc.UShort}:    template.Must(template.New("ushort").Parse("C.ushort({{.}})")),
+	{Kind: cc.Int}:       template.Must(template.New("int").Parse("C.int({{.}})")),
+	{Kind: cc.UInt}:      template.Must(template.New("uint").Parse("C.uint({{.}})")),
+	{Kind: cc.Long}:      template.Must(template.New("long").Parse("C.long({{.}})")),
+	{Kind: cc.ULong}:     template.Must(template.New("ulong").Parse("C.ulong({{.}})")),
+	{Kind: cc.LongLong}:  template.Must(template.New("longlong").Parse("C.longlong({{.}})")),
+	{Kind: cc.ULongLong}: template.Must(template.New("ulonglong").Parse("C.ulonglong({{.}})")),
+
+	{Kind: cc.Char, IsPointer: true}:                template.Must(template.New("char*").Parse("(*C.char)(unsafe.Pointer(&{{.}}[0]))")),
+	{Kind: cc.Char, IsPointer: true, IsConst: true}: template.Must(template.New("const char*").Parse("c{{.}}")),
+	{Kind: cc.SChar, IsPointer: true}:               template.Must(template.New("schar*").Parse("(*C.schar)(&{{.}}[0])")),
+	{Kind: cc.UChar, IsPointer: true}:               template.Must(template.New("uchar*").Parse("(*C.uchar)(&{{.}}[0])")),
+	{Kind: cc.Short, IsPointer: true}:               template.Must(template.New("short*").Parse("(*C.short)(&{{.}}[0])")),
+	{Kind: cc.UShort, IsPointer: true}:              template.Must(template.New("ushort*").Parse("(*C.ushort)(&{{.}}[0])")),
+	{Kind: cc.Int, IsPointer: true}:                 template.Must(template.New("int*").Parse("(*C.int)(&{{.}}[0])")),
+	{Kind: cc.UInt, IsPointer: true}:                template.Must(template.New("uint*").Parse("(*C.uint)(&{{.}}[0])")),
+	{Kind: cc.Long, IsPointer: true}:                template.Must(template.New("long*").Parse("(*C.long)(unsafe.Pointer(&{{.}}[0]))")),
+	{Kind: cc.ULong, IsPointer: true}:               template.Must(template.New("ulong*").Parse("(*C.ulong)(unsafe.Pointer(&{{.}}[0]))")),
+	{Kind: cc.LongLong, IsPointer: true}:            template.Must(template.New("longlong*").Parse("(*C.longlong)(&{{.}}[0])")),
+	{Kind: cc.ULongLong, IsPointer: true}:           template.Must(template.New("ulonglong*").Parse("(*C.ulonglong)(&{{.}}[0])")),
 
 	{Kind: cc.Float}:  template.Must(template.New("float").Parse("C.float({{.}})")),
 	{Kind: cc.Double}: template.Must(template.New("double").Parse("C.double({{.}})")),
@@ -149,7 +356,8 @@ var cgoTypes = map[TypeKey]*template.Template{
 	{Kind: cc.Float, IsPointer: true}:  template.Must(template.New("float*").Parse("(*C.float)(&{{.}}[0])")),
 	{Kind: cc.Double, IsPointer: true}: template.Must(template.New("double*").Parse("(*C.double)(&{{.}}[0])")),
 
-	{Kind: cc.Bool}: template.Must(template.New("bool").Parse("C.bool({{.}})")),
+	{Kind: cc.Bool}:                  template.Must(template.New("bool").Parse("C.bool({{.}})")),
+	{Kind: cc.Bool, IsPointer: true}: template.Must(template.New("bool*").Parse("(*C.bool)(&{{.}}[0])")),
 
 	{Kind: cc.FloatComplex}:                   template.Must(template.New("floatcomplex").Parse("unsafe.Pointer({{.}})")),
 	{Kind: cc.DoubleComplex}:                  template.Must(template.New("doublecomplex").Parse("unsafe.Pointer({{.}})")),
@@ -158,75 +366,153 @@ var cgoTypes = map[TypeKey]*template.Template{
 }
 
 // CgoConversionFor returns a string representation of the given type using a mapping in
-// types. CgoConversionFor will panic if no type mapping is found after searching the
-// user-provided types mappings and then the following mapping:
-//  {Kind: cc.Void, IsPointer: true}:          "unsafe.Pointer(&{{.}}[0])",
-//  {Kind: cc.Int}:                            "C.int({{.}})",
-//  {Kind: cc.Float}:                          "C.float({{.}})",
-//  {Kind: cc.Float, IsPointer: true}:         "(*C.float)({{.}})",
-//  {Kind: cc.Double}:                         "C.double({{.}})",
-//  {Kind: cc.Double, IsPointer: true}:        "(*C.double)({{.}})",
-//  {Kind: cc.Bool}:                           "C.bool({{.}})",
-//  {Kind: cc.FloatComplex}:                   "unsafe.Pointer(&{{.}})",
-//  {Kind: cc.DoubleComplex}:                  "unsafe.Pointer(&{{.}})",
-//  {Kind: cc.FloatComplex, IsPointer: true}:  "unsafe.Pointer(&{{.}}[0])",
-//  {Kind: cc.DoubleComplex, IsPointer: true}: "unsafe.Pointer(&{{.}}[0])",
+// types. The user-provided types mappings are searched for each key matching the
+// type, in the order described by TypeKey, and then the following mapping is:
+//  {Kind: cc.Void, IsPointer: true}:                "unsafe.Pointer(&{{.}}[0])",
+//  {Kind: cc.Char}:                                 "C.char({{.}})",
+//  {Kind: cc.SChar}:                                "C.schar({{.}})",
+//  {Kind: cc.UChar}:                                "C.uchar({{.}})",
+//  {Kind: cc.Short}:                                "C.short({{.}})",
+//  {Kind: cc.UShort}:                               "C.ushort({{.}})",
+//  {Kind: cc.Int}:                                  "C.int({{.}})",
+//  {Kind: cc.UInt}:                                 "C.uint({{.}})",
+//  {Kind: cc.Long}:                                 "C.long({{.}})",
+//  {Kind: cc.ULong}:                                "C.ulong({{.}})",
+//  {Kind: cc.LongLong}:                             "C.longlong({{.}})",
+//  {Kind: cc.ULongLong}:                            "C.ulonglong({{.}})",
+//  {Kind: cc.Char, IsPointer: true}:                "(*C.char)(unsafe.Pointer(&{{.}}[0]))",
+//  {Kind: cc.Char, IsPointer: true, IsConst: true}: "c{{.}}",
+//  {Kind: cc.SChar, IsPointer: true}:               "(*C.schar)(&{{.}}[0])",
+//  {Kind: cc.UChar, IsPointer: true}:               "(*C.uchar)(&{{.}}[0])",
+//  {Kind: cc.Short, IsPointer: true}:               "(*C.short)(&{{.}}[0])",
+//  {Kind: cc.UShort, IsPointer: true}:              "(*C.ushort)(&{{.}}[0])",
+//  {Kind: cc.Int, IsPointer: true}:                 "(*C.int)(&{{.}}[0])",
+//  {Kind: cc.UInt, IsPointer: true}:                "(*C.uint)(&{{.}}[0])",
+//  {Kind: cc.Long, IsPointer: true}:                "(*C.long)(unsafe.Pointer(&{{.}}[0]))",
+//  {Kind: cc.ULong, IsPointer: true}:               "(*C.ulong)(unsafe.Pointer(&{{.}}[0]))",
+//  {Kind: cc.LongLong, IsPointer: true}:            "(*C.longlong)(&{{.}}[0])",
+//  {Kind: cc.ULongLong, IsPointer: true}:           "(*C.ulonglong)(&{{.}}[0])",
+//  {Kind: cc.Float}:                                "C.float({{.}})",
+//  {Kind: cc.Double}:                               "C.double({{.}})",
+//  {Kind: cc.Float, IsPointer: true}:               "(*C.float)(&{{.}}[0])",
+//  {Kind: cc.Double, IsPointer: true}:              "(*C.double)(&{{.}}[0])",
+//  {Kind: cc.Bool}:                                 "C.bool({{.}})",
+//  {Kind: cc.Bool, IsPointer: true}:                "(*C.bool)(&{{.}}[0])",
+//  {Kind: cc.FloatComplex}:                         "unsafe.Pointer({{.}})",
+//  {Kind: cc.DoubleComplex}:                        "unsafe.Pointer({{.}})",
+//  {Kind: cc.FloatComplex, IsPointer: true}:        "unsafe.Pointer(&{{.}}[0])",
+//  {Kind: cc.DoubleComplex, IsPointer: true}:       "unsafe.Pointer(&{{.}}[0])",
+// The conversion of a const char* parameter is the C string allocated by the
+// statements returned by CgoPrologueFor. A struct or union type with a tag or
+// typedef name that is not mapped is converted from the Go type given by GoTypeFor to the C type by
+// pointer conversion. CgoConversionFor will panic if no type mapping is found or
+// the template fails; the Declaration method of the same name returns an error
+// instead.
 func CgoConversionFor(name string, typ cc.Type, types ...map[TypeKey]*template.Template) string {
+	s, err := cgoConversionFor(name, typ, types)
+	if err != nil {
+		panic(mapPanic(typ, err))
+	}
+	return s
+}
+
+func cgoConversionFor(name string, typ cc.Type, types []map[TypeKey]*template.Template) (string, error) {
 	if typ == nil {
-		return "<nil>"
-	}
-	k := typ.Kind()
-	isPtr := typ.Kind() == cc.Ptr
-	if isPtr {
-		k = typ.Element().Kind()
-	}
-	for _, t := range types {
-		if s, ok := t[TypeKey{Kind: k, IsPointer: isPtr}]; ok {
-			var buf bytes.Buffer
-			err := s.Execute(&buf, name)
-			if err != nil {
-				panic(err)
-			}
-			return buf.String()
-		}
+		return "<nil>", nil
+	}
+	k := TypeKeyFor(typ)
+	if s, ok := lookup(k, types, cgoTypes); ok {
+		return execute(s, name)
 	}
-	s, ok := cgoTypes[TypeKey{Kind: k, IsPointer: isPtr}]
-	if ok {
-		var buf bytes.Buffer
-		err := s.Execute(&buf, name)
-		if err != nil {
-			panic(err)
+	if _, c, ok := structNames(typ, k.IsPointer); ok {
+		if k.IsPointer {
+			return fmt.Sprintf("(*%s)(unsafe.Pointer(%s))", c, name), nil
 		}
-		return buf.String()
+		return fmt.Sprintf("*(*%s)(unsafe.Pointer(&%s))", c, name), nil
 	}
-	panic(fmt.Sprintf("unknown type key: %+v", TypeKey{Kind: k, IsPointer: isPtr}))
+	return "", ErrNoMapping
 }
 
 // CgoConversionForEnum returns a string representation of the given enum type using a mapping
-// in types. GoTypeForEnum will panic if no type mapping is found after searching the
-// user-provided types mappings or the type is not an enum.
+// in types. CgoConversionForEnum will panic if no type mapping is found after searching
+// the user-provided types mappings, the template fails or the type is not an enum; the
+// Declaration method of the same name returns an error instead.
 func CgoConversionForEnum(name string, typ cc.Type, types ...map[string]*template.Template) string {
+	s, err := enumFor(typ, name, types)
+	if err != nil {
+		panic(mapPanic(typ, err))
+	}
+	return s
+}
+
+var cgoPrologues = map[TypeKey]*template.Template{
+	{Kind: cc.Char, IsPointer: true, IsConst: true}: template.Must(template.New("const char*").Parse(`c{{.}} := C.CString({{.}})
+defer C.free(unsafe.Pointer(c{{.}}))`)),
+}
+
+// CgoPrologueFor returns the statements that must precede a call passing the
+// Go value name as the given type, using a mapping in types, or the empty
+// string if there are none. The user-provided types mappings are searched for
+// each key matching the type, in the order described by TypeKey, and then the
+// following mapping is:
+//  {Kind: cc.Char, IsPointer: true, IsConst: true}: "c{{.}} := C.CString({{.}})\ndefer C.free(unsafe.Pointer(c{{.}}))",
+// The C string allocated for a const char* parameter is freed when the calling
+// function returns, so the C function must not retain it. Code calling C.free
+// must include stdlib.h.
+func CgoPrologueFor(name string, typ cc.Type, types ...map[TypeKey]*template.Template) string {
 	if typ == nil {
-		return "<nil>"
+		return ""
 	}
-	if typ.Kind() != cc.Enum {
-		panic(fmt.Sprintf("invalid type: %v", typ))
+	s, ok := lookup(TypeKeyFor(typ), types, cgoPrologues)
+	if !ok {
+		return ""
 	}
-	tag := typ.Tag()
-	if tag != 0 {
-		n := string(xc.Dict.S(tag))
-		for _, t := range types {
-			if s, ok := t[n]; ok {
-				var buf bytes.Buffer
-				err := s.Execute(&buf, name)
-				if err != nil {
-					panic(err)
-				}
-				return buf.String()
-			}
+	var buf bytes.Buffer
+	err := s.Execute(&buf, name)
+	if err != nil {
+		panic(err)
+	}
+	return buf.String()
+}
+
+var goResults = map[TypeKey]*template.Template{
+	{Kind: cc.Char, IsPointer: true, IsConst: true}: template.Must(template.New("const char*").Parse("C.GoString({{.}})")),
+}
+
+// GoResultFor returns a string representation of the conversion of the C value
+// name of the given type to the Go type goType, using a mapping in types. The
+// user-provided types mappings are searched for each key matching the type, in
+// the order described by TypeKey, and then the following mapping is:
+//  {Kind: cc.Char, IsPointer: true, IsConst: true}: "C.GoString({{.}})",
+// If no mapping is found, or the mapping found gives the empty string, the value
+// is converted with a Go conversion to goType, which is usually given by
+// GoTypeFor. Values of pointer, struct and union types have no such conversion. GoResultFor will panic if no type mapping is found for
+// such a value or the template fails; the Declaration method of the same name
+// returns an error instead.
+func GoResultFor(name string, typ cc.Type, goType string, types ...map[TypeKey]*template.Template) string {
+	s, err := goResultFor(name, typ, goType, types)
+	if err != nil {
+		panic(mapPanic(typ, err))
+	}
+	return s
+}
+
+func goResultFor(name string, typ cc.Type, goType string, types []map[TypeKey]*template.Template) (string, error) {
+	if typ == nil {
+		return "<nil>", nil
+	}
+	k := TypeKeyFor(typ)
+	if s, ok := lookup(k, types, goResults); ok {
+		r, err := execute(s, name)
+		if r != "" || err != nil {
+			return r, err
 		}
 	}
-	panic(fmt.Sprintf("unknown type: %+v", typ))
+	switch typ.Kind() {
+	case cc.Ptr, cc.Struct, cc.Union:
+		return "", ErrNoMapping
+	}
+	return fmt.Sprintf("%s(%s)", goType, name), nil
 }
 
 // LowerCaseFirst returns s with the first character lower-cased. LowerCaseFirst
@@ -314,6 +600,14 @@ func (p *Parameter) Kind() cc.Kind { return p.Parameter.Type.Kind() }
 // array parameter.
 func (p *Parameter) Elem() cc.Type { return p.Parameter.Type.Element() }
 
+// IsConstPointer returns whether the parameter is a pointer to a const qualified
+// type. The memory referenced by such a parameter is only read by the function,
+// while that referenced by other pointer parameters may be written.
+func (p *Parameter) IsConstPointer() bool {
+	k := TypeKeyFor(p.Parameter.Type)
+	return k.IsPointer && k.IsConst
+}
+
 // Parameters returns the declaration's CParameters converted to a []Parameter.
 func (d *Declaration) Parameters() []Parameter {
 	p := make([]Parameter, len(d.CParameters))
@@ -323,30 +617,19 @@ func (d *Declaration) Parameters() []Parameter {
 	return p
 }
 
-// Declarations returns the C function declarations in the givel set of file paths.
+// Declarations returns the C function declarations in the givel set of file paths,
+// parsed with the zero Config.
 func Declarations(paths ...string) ([]Declaration, error) {
-	predefined, includePaths, sysIncludePaths, err := cc.HostConfig()
-	if err != nil {
-		return nil, fmt.Errorf("binding: failed to get host config: %v", err)
-	}
-
-	t, err := cc.Parse(
-		predefined+`
-#define __const const
-#define __attribute__(...)
-#define __extension__
-#define __inline
-#define __restrict
-unsigned __builtin_bswap32 (unsigned x);
-unsigned long long __builtin_bswap64 (unsigned long long x);
-`,
-		paths,
-		model(),
-		cc.IncludePaths(includePaths),
-		cc.SysIncludePaths(sysIncludePaths),
-	)
+	var c Config
+	return c.Declarations(paths...)
+}
+
+// Declarations returns the C function declarations in the given set of file paths,
+// parsed with the configuration c.
+func (c *Config) Declarations(paths ...string) ([]Declaration, error) {
+	t, err := c.parse(paths)
 	if err != nil {
-		return nil, fmt.Errorf("binding: failed to parse %q: %v", paths, err)
+		return nil, err
 	}
 
 	var decls []Declaration
@@ -397,6 +680,40 @@ unsigned long long __builtin_bswap64 (unsigned long long x);
 	return decls, nil
 }
 
+// walkTypes calls visit with the type of each declarator of the external
+// declarations in t, with the result and parameter types of function
+// declarations in place of the function type. For a typedef, name is the
+// typedef name and is otherwise empty.
+func walkTypes(t *cc.TranslationUnit, visit func(typ cc.Type, name string, pos token.Pos)) {
+	for ; t != nil; t = t.TranslationUnit {
+		if t.ExternalDeclaration.Case != 1 /* Declaration */ {
+			continue
+		}
+		d := t.ExternalDeclaration.Declaration
+		if d.Case != 0 || d.InitDeclaratorListOpt == nil {
+			continue
+		}
+		for idl := d.InitDeclaratorListOpt.InitDeclaratorList; idl != nil; idl = idl.InitDeclaratorList {
+			declarator := idl.InitDeclarator.Declarator
+			pos := declarator.Pos()
+			typ := declarator.Type
+			switch {
+			case declarator.RawSpecifier().IsTypedef():
+				name, _ := declarator.Identifier()
+				visit(typ, string(xc.Dict.S(name)), pos)
+			case typ.Kind() == cc.Function:
+				visit(typ.Result(), "", pos)
+				params, _ := typ.Parameters()
+				for _, p := range params {
+					visit(p.Type, "", pos)
+				}
+			default:
+				visit(typ, "", pos)
+			}
+		}
+	}
+}
+
 type byPosition []Declaration
 
 func (d byPosition) Len() int { return len(d) }
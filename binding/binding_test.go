@@ -0,0 +1,150 @@
+// Copyright ©2016 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package binding
+
+import (
+	"testing"
+	"text/template"
+
+	"github.com/cznic/cc"
+)
+
+// declaration returns the declaration named name in the file path.
+func declaration(t *testing.T, path, name string) *Declaration {
+	requireHost(t)
+	decls, err := Declarations(path)
+	if err != nil {
+		t.Fatalf("unexpected error parsing %s: %v", path, err)
+	}
+	for i, d := range decls {
+		if d.Name == name {
+			return &decls[i]
+		}
+	}
+	t.Fatalf("no declaration of %s in %s", name, path)
+	return nil
+}
+
+func TestTypedefMapping(t *testing.T) {
+	d := declaration(t, "testdata/typedef.h", "typedef_func")
+
+	types := map[TypeKey]*template.Template{
+		{Name: "lapack_int"}:                  template.Must(template.New("lapack_int").Parse("int32")),
+		{Name: "lapack_int", IsPointer: true}: template.Must(template.New("lapack_int*").Parse("[]int32")),
+	}
+
+	params := d.Parameters()
+	for i, want := range []struct {
+		key    TypeKey
+		goType string
+	}{
+		{key: TypeKey{Kind: cc.Int, Name: "lapack_int"}, goType: "int32"},
+		{key: TypeKey{Kind: cc.Int}, goType: "int"},
+		{key: TypeKey{Kind: cc.Int, Name: "lapack_int", IsPointer: true, IsConst: true}, goType: "[]int32"},
+		{key: TypeKey{Kind: cc.Int, Name: "lapack_int", IsPointer: true}, goType: "[]int32"},
+	} {
+		p := params[i]
+		if got := TypeKeyFor(p.Type()); got != want.key {
+			t.Errorf("unexpected type key for %s: got %+v want %+v", p.Name(), got, want.key)
+		}
+		if got := GoTypeFor(p.Type(), p.Name(), types); got != want.goType {
+			t.Errorf("unexpected Go type for %s: got %q want %q", p.Name(), got, want.goType)
+		}
+	}
+	if got := GoTypeFor(d.Return, "", types); got != "int32" {
+		t.Errorf("unexpected Go result type: got %q want %q", got, "int32")
+	}
+	if got := GoTypeFor(d.Return, ""); got != "int" {
+		t.Errorf("unexpected default Go result type: got %q want %q", got, "int")
+	}
+}
+
+func TestIsConstPointer(t *testing.T) {
+	d := declaration(t, "testdata/const.h", "const_func")
+	want := map[string]bool{
+		"a": true,  // const double *
+		"b": false, // double *const
+		"c": false, // const double **
+		"d": false, // double *
+		"e": true,  // const double *const
+		"f": true,  // double const *
+		"g": false, // dptr
+		"s": true,  // const char *
+	}
+	params := d.Parameters()
+	if len(params) != len(want) {
+		t.Fatalf("unexpected number of parameters: got %d want %d", len(params), len(want))
+	}
+	for _, p := range params {
+		if got := p.IsConstPointer(); got != want[p.Name()] {
+			t.Errorf("unexpected IsConstPointer for %s %s: got %t want %t", p.Type(), p.Name(), got, want[p.Name()])
+		}
+	}
+}
+
+func TestLongMapping(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		model DataModel
+		want  []string
+	}{
+		{name: "LP64", model: LP64, want: []string{"int64", "[]uint64", "int", "[]int32"}},
+		{name: "ILP32", model: ILP32, want: []string{"int", "[]uint32", "int", "[]int32"}},
+		{name: "LLP64", model: LLP64, want: []string{"int", "[]uint32", "int", "[]int32"}},
+	} {
+		c := Config{Model: test.model, NoHost: true}
+		decls, err := c.Declarations("testdata/long.h")
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %v", test.name, err)
+		}
+		var d *Declaration
+		for i := range decls {
+			if decls[i].Name == "long_func" {
+				d = &decls[i]
+			}
+		}
+		if d == nil {
+			t.Fatalf("no declaration of long_func for %s", test.name)
+		}
+		for i, p := range d.Parameters() {
+			if got := GoTypeFor(p.Type(), p.Name()); got != test.want[i] {
+				t.Errorf("unexpected Go type for %s %s: got %q want %q", test.name, p.Name(), got, test.want[i])
+			}
+		}
+		if got := GoTypeFor(d.Return, ""); got != test.want[0] {
+			t.Errorf("unexpected Go result type for %s: got %q want %q", test.name, got, test.want[0])
+		}
+	}
+}
+
+func TestUserMappingPrecedence(t *testing.T) {
+	d := declaration(t, "testdata/const.h", "const_func")
+	var s *Parameter
+	params := d.Parameters()
+	for i := range params {
+		if params[i].Name() == "s" {
+			s = &params[i]
+		}
+	}
+	if s == nil {
+		t.Fatal("no parameter s of const_func")
+	}
+
+	goTypes := map[TypeKey]*template.Template{
+		{Kind: cc.Char, IsPointer: true}: template.Must(template.New("char*").Parse("[]byte")),
+	}
+	cgoTypes := map[TypeKey]*template.Template{
+		{Kind: cc.Char, IsPointer: true}: template.Must(template.New("char*").Parse("(*C.char)(unsafe.Pointer(&{{.}}[0]))")),
+	}
+	if got, want := GoTypeFor(s.Type(), s.Name(), goTypes), "[]byte"; got != want {
+		t.Errorf("unexpected Go type: got %q want %q", got, want)
+	}
+	if got, want := CgoConversionFor(s.Name(), s.Type(), cgoTypes), "(*C.char)(unsafe.Pointer(&s[0]))"; got != want {
+		t.Errorf("unexpected cgo conversion: got %q want %q", got, want)
+	}
+	if got, want := GoTypeFor(s.Type(), s.Name()), "string"; got != want {
+		t.Errorf("unexpected default Go type: got %q want %q", got, want)
+	}
+}
@@ -0,0 +1,809 @@
+// Copyright ©2016 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// The bindgen command generates a cgo wrapper package for the functions
+// declared in a C header.
+//
+// Usage:
+//  bindgen [-config map.json] [-target model] [-I dir] [-D name[=value]] [-nohost]
+//  	[-pkg name] [-o file.go] header.h
+//
+// Each function declared in the header, other than variadic functions,
+// is wrapped by a Go function that converts its parameters with
+// binding.CgoConversionFor and its result to the type given by
+// binding.GoTypeFor with binding.GoResultFor; pointer, struct and union
+// results must be converted by a results template. The declarations of
+// included headers are not wrapped. Each complete struct and union
+// returned by binding.Structs that has no bit fields and is declared in
+// the header or used by a wrapped function is defined by
+// binding.GoStructFor, and each such enum returned by binding.Enums is
+// defined by binding.GoEnumFor and used for the parameters and results
+// of its type. The object-like macros returned by binding.Macros are
+// defined as constants by binding.GoConstFor. The output is formatted
+// with gofmt.
+//
+// The header is parsed for the data model of the host unless a target is
+// given, either a data model name, LP64, ILP32 or LLP64, or a GOOS/GOARCH
+// pair such as windows/amd64. The target's data model determines the
+// layout of the generated structs; architecture and operating system
+// macros are still those of the host.
+//
+// The -I and -D flags, which may be repeated, add include directories
+// and macro definitions as for a C compiler. With -nohost the header is
+// parsed without a host C compiler, using the minimal libc headers of
+// the binding package, as described by binding.Config.
+//
+// If the types of any functions cannot be mapped, bindgen reports the
+// position, function, parameter and C type of every failure and exits
+// without writing output.
+//
+// The mapping config is a JSON object such as the following, in which
+// all fields are optional:
+//  {
+//  	"cgo":      ["LDFLAGS: -llapacke"],
+//  	"includes": ["lapacke.h"],
+//  	"prefix":   "LAPACKE_",
+//  	"names":    {"LAPACKE_xerbla": "-"},
+//  	"docs":     "../lapack/gonum",
+//  	"goTypes":  {"lapack_int": "int32", "lapack_int*": "[]int32"},
+//  	"cgoTypes": {"lapack_int": "C.lapack_int({{.}})"},
+//  	"lengths":  {"LAPACKE_dgesv.ipiv": "n"}
+//  }
+// The cgo entries are written as #cgo directives. The includes are the
+// headers included by the cgo preamble, by default the input header.
+// A "predefined" source, if given, even if empty, replaces
+// binding.DefaultPredefined and so must hide any compiler extensions the
+// headers use; plain macro definitions are better given with -D.
+// The prefix is removed from C function names before the first letter
+// is upper-cased to form the Go name. Names maps C function and macro
+// names and enum tags and typedef names to the names of the Go
+// functions, constants and types that are generated for them, with "-"
+// excluding a declaration; struct tags and typedef names may only be
+// mapped to "-". Doc comments are copied from the functions of the Go
+// package in the docs directory that have the same Go name.
+//
+// The goTypes, cgoTypes, prologues and results templates are keyed by C
+// type, a C arithmetic type name or typedef name optionally preceded by
+// "const" and followed by "*". They are searched as described by
+// binding.TypeKey before the mappings of the generated types and the
+// binding package defaults, and are passed to binding.GoTypeFor,
+// binding.CgoConversionFor, binding.CgoPrologueFor and
+// binding.GoResultFor respectively. By default a const char* parameter
+// is a Go string copied to a C string that is freed when the wrapper
+// returns, and a const char* result is copied to a Go string, unless the
+// C type is given a Go type in goTypes.
+//
+// The doc comment of a wrapper states which slice parameters are only
+// read by the C function, those for pointers to const qualified types,
+// and which may be written. The lengths are Go expressions in terms of
+// the parameters of a function, keyed by C function and parameter name,
+// that give the minimum length of a written slice. The wrapper panics
+// if the slice is shorter. Lengths are not checked for slices that are
+// only read.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/cznic/cc"
+
+	"github.com/gonum/internal/binding"
+)
+
+// config is the mapping config read from the -config file.
+type config struct {
+	Cgo      []string          `json:"cgo"`
+	Includes []string          `json:"includes"`
+	Prefix   string            `json:"prefix"`
+	Names    map[string]string `json:"names"`
+	Docs     string            `json:"docs"`
+	GoTypes  map[string]string `json:"goTypes"`
+	CgoTypes map[string]string `json:"cgoTypes"`
+	Lengths  map[string]string `json:"lengths"`
+
+	Prologues map[string]string `json:"prologues"`
+	Results   map[string]string `json:"results"`
+
+	// Predefined replaces binding.DefaultPredefined if it is
+	// given, even if it is empty.
+	Predefined *string `json:"predefined"`
+}
+
+// stringList is a flag.Value holding the values of a repeated flag.
+type stringList []string
+
+func (l *stringList) String() string { return strings.Join(*l, ",") }
+
+func (l *stringList) Set(s string) error {
+	*l = append(*l, s)
+	return nil
+}
+
+func main() {
+	cfgPath := flag.String("config", "", "path to the JSON mapping config")
+	target := flag.String("target", "", "target data model, LP64, ILP32, LLP64 or GOOS/GOARCH (default the host)")
+	var includes, defines stringList
+	flag.Var(&includes, "I", "add `dir` to the include search path (may be repeated)")
+	flag.Var(&defines, "D", "define the macro `name[=value]` (may be repeated)")
+	noHost := flag.Bool("nohost", false, "parse without a host C compiler using bundled libc headers")
+	pkg := flag.String("pkg", "", "name of the generated package (default the header's base name)")
+	out := flag.String("o", "", "output file (default standard output)")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: bindgen [-config map.json] [-target model] [-I dir] [-D name[=value]] [-nohost] [-pkg name] [-o file.go] header.h\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	log.SetFlags(0)
+	log.SetPrefix("bindgen: ")
+
+	header := flag.Arg(0)
+	var cfg config
+	if *cfgPath != "" {
+		b, err := ioutil.ReadFile(*cfgPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = json.Unmarshal(b, &cfg)
+		if err != nil {
+			log.Fatalf("failed to parse %s: %v", *cfgPath, err)
+		}
+	}
+	if *pkg == "" {
+		*pkg = strings.TrimSuffix(filepath.Base(header), filepath.Ext(header))
+	}
+
+	pc := binding.Config{
+		IncludePaths: includes,
+		Defines:      defines,
+		NoHost:       *noHost,
+	}
+	if cfg.Predefined != nil {
+		pc.Predefined = *cfg.Predefined
+		pc.NoDefaultPredefined = true
+	}
+	if *target != "" {
+		m, err := targetModel(*target)
+		if err != nil {
+			log.Fatal(err)
+		}
+		pc.Model = m
+	}
+
+	src, err := generate(header, *pkg, cfg, &pc)
+	if errs, ok := err.(binding.TypeErrors); ok {
+		for _, e := range errs {
+			log.Print(e)
+		}
+		os.Exit(1)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *out == "" {
+		os.Stdout.Write(src)
+		return
+	}
+	err = ioutil.WriteFile(*out, src, 0664)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate returns the formatted source of the wrapper package pkg for
+// the functions declared in header, parsed with the configuration pc. If the types of any functions cannot
+// be mapped, generate returns a binding.TypeErrors holding every failure.
+func generate(header, pkg string, cfg config, pc *binding.Config) ([]byte, error) {
+	var m mapping
+	var err error
+	m.goTypes, err = typeMap(cfg.GoTypes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid goTypes: %v", err)
+	}
+	m.cgoTypes, err = typeMap(cfg.CgoTypes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cgoTypes: %v", err)
+	}
+	m.prologues, err = typeMap(cfg.Prologues)
+	if err != nil {
+		return nil, fmt.Errorf("invalid prologues: %v", err)
+	}
+	m.results, err = typeMap(cfg.Results)
+	if err != nil {
+		return nil, fmt.Errorf("invalid results: %v", err)
+	}
+	// A C type given a Go type by the user is not copied by the
+	// package default prologues and results for that Go type.
+	mask(m.prologues, m.goTypes)
+	mask(m.results, m.goTypes)
+	var docs map[string][]*ast.Comment
+	if cfg.Docs != "" {
+		d, err := binding.DocComments(cfg.Docs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read doc comments: %v", err)
+		}
+		docs = d[""]
+	}
+	decls, err := pc.Declarations(header)
+	if err != nil {
+		return nil, err
+	}
+	decls, err = declaredIn(header, decls)
+	if err != nil {
+		return nil, err
+	}
+
+	structs, err := pc.Structs(header)
+	if err != nil {
+		return nil, err
+	}
+
+	enums, err := pc.Enums(header)
+	if err != nil {
+		return nil, err
+	}
+
+	macros, err := pc.Macros(header)
+	if err != nil {
+		return nil, err
+	}
+
+	var body bytes.Buffer
+	var consts []string
+	for _, mc := range macros {
+		name := cfg.Names[mc.Name]
+		if name == "-" {
+			continue
+		}
+		if f, ok := mc.Value.(float64); ok && (math.IsInf(f, 0) || math.IsNaN(f)) {
+			log.Printf("%v: skipping non-finite macro %s", mc.Position(), mc.Name)
+			continue
+		}
+		consts = append(consts, binding.GoConstFor(&mc, name))
+	}
+	if len(consts) != 0 {
+		fmt.Fprintf(&body, "\n// Constants defined by %s.\nconst (\n", filepath.Base(header))
+		for _, c := range consts {
+			fmt.Fprintf(&body, "\t%s\n", c)
+		}
+		body.WriteString(")\n")
+	}
+	m.goGenerated = make(map[binding.TypeKey]*template.Template)
+	m.cgoGenerated = make(map[binding.TypeKey]*template.Template)
+	m.goEnums = make(map[string]*template.Template)
+	m.cgoEnums = make(map[string]*template.Template)
+	used := usedTypes(decls)
+	for _, e := range enums {
+		if !inFile(header, e.Position()) && !used[e.Type.String()] {
+			continue
+		}
+		name := e.GoName()
+		if n, ok := cfg.Names[e.Name]; ok && e.Name != "" {
+			name = n
+		} else if n, ok := cfg.Names[e.Tag]; ok && e.Tag != "" {
+			name = n
+		}
+		if name == "-" {
+			continue
+		}
+		if e.Name != "" {
+			m.generate(binding.TypeKey{Name: e.Name}, name, e.CName()+"({{.}})")
+		}
+		if e.Tag != "" {
+			m.goEnums[e.Tag] = template.Must(template.New(e.Tag).Parse(name))
+			m.cgoEnums[e.Tag] = template.Must(template.New(e.Tag).Parse(e.CName() + "({{.}})"))
+		}
+		fmt.Fprintln(&body)
+		fmt.Fprintf(&body, "// %s is %s.\n", name, e.CName())
+		body.WriteString(binding.GoEnumFor(&e, name))
+	}
+	for _, s := range structs {
+		if !inFile(header, s.Position()) && !used[s.Type.String()] {
+			continue
+		}
+		if s.Incomplete || cfg.Names[s.Name] == "-" || cfg.Names[s.Tag] == "-" {
+			continue
+		}
+		if hasBitField(s) {
+			log.Printf("%v: skipping %s with bit fields", s.Position(), s.Type)
+			continue
+		}
+		if s.Name != "" {
+			m.generate(binding.TypeKey{Name: s.Name}, s.GoName(), "*(*"+s.CName()+")(unsafe.Pointer(&{{.}}))")
+			m.generate(binding.TypeKey{Name: s.Name, IsPointer: true}, "*"+s.GoName(), "(*"+s.CName()+")(unsafe.Pointer({{.}}))")
+		}
+		fmt.Fprintln(&body)
+		fmt.Fprintf(&body, "// %s has the memory layout of %s.\n", s.GoName(), s.CName())
+		body.WriteString(binding.GoStructFor(&s))
+	}
+	var errs binding.TypeErrors
+	for _, d := range decls {
+		name := goName(d.Name, cfg)
+		if name == "-" {
+			continue
+		}
+		if d.Variadic {
+			log.Printf("%v: skipping variadic function %s", d.Position(), d.Name)
+			continue
+		}
+		var fn bytes.Buffer
+		if !writeFunc(&fn, name, &d, docs[name], &m, cfg.Lengths, &errs) {
+			continue
+		}
+		fmt.Fprintln(&body)
+		body.Write(fn.Bytes())
+	}
+	if errs != nil {
+		return nil, errs
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by \"bindgen %s\"; DO NOT EDIT.\n\n", filepath.Base(header))
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+	fmt.Fprintln(&buf, "/*")
+	for _, c := range cfg.Cgo {
+		fmt.Fprintf(&buf, "#cgo %s\n", c)
+	}
+	uses, err := selectors(body.Bytes())
+	if err != nil {
+		return body.Bytes(), fmt.Errorf("failed to parse generated code: %v", err)
+	}
+	includes := cfg.Includes
+	if len(includes) == 0 {
+		includes = []string{filepath.Base(header)}
+	}
+	if uses["C"]["free"] {
+		includes = append([]string{"stdlib.h"}, includes...)
+	}
+	for _, h := range includes {
+		if h == "stdlib.h" {
+			fmt.Fprintln(&buf, "#include <stdlib.h>")
+			continue
+		}
+		fmt.Fprintf(&buf, "#include %q\n", h)
+	}
+	fmt.Fprintln(&buf, "*/")
+	fmt.Fprintln(&buf, `import "C"`)
+	for _, imp := range []string{"fmt", "unsafe"} {
+		if uses[imp] != nil {
+			fmt.Fprintf(&buf, "import %q\n", imp)
+		}
+	}
+	buf.Write(body.Bytes())
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return buf.Bytes(), fmt.Errorf("failed to format generated code: %v", err)
+	}
+	return src, nil
+}
+
+// declaredIn returns the declarations of decls that are in the file path,
+// excluding those of the included headers and the predefined source.
+func declaredIn(path string, decls []binding.Declaration) ([]binding.Declaration, error) {
+	if _, err := filepath.Abs(path); err != nil {
+		return nil, err
+	}
+	var in []binding.Declaration
+	for _, d := range decls {
+		if inFile(path, d.Position()) {
+			in = append(in, d)
+		}
+	}
+	return in, nil
+}
+
+// inFile returns whether pos is in the file path.
+func inFile(path string, pos token.Position) bool {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return false
+	}
+	f, err := filepath.Abs(pos.Filename)
+	return err == nil && f == abs
+}
+
+// usedTypes returns the set of the struct, union and enum types used by
+// the parameters and results of decls and by the fields of those types,
+// keyed by their C type string, so that the types declared by included
+// headers are generated only if they are needed.
+func usedTypes(decls []binding.Declaration) map[string]bool {
+	used := make(map[string]bool)
+	var use func(typ cc.Type)
+	use = func(typ cc.Type) {
+		for typ != nil && (typ.Kind() == cc.Ptr || typ.Kind() == cc.Array) {
+			typ = typ.Element()
+		}
+		if typ == nil {
+			return
+		}
+		switch typ.Kind() {
+		case cc.Struct, cc.Union:
+			if used[typ.String()] {
+				return
+			}
+			used[typ.String()] = true
+			members, _ := typ.Members()
+			for _, m := range members {
+				use(m.Type)
+			}
+		case cc.Enum:
+			used[typ.String()] = true
+		}
+	}
+	for _, d := range decls {
+		use(d.Return)
+		for _, p := range d.Parameters() {
+			use(p.Type())
+		}
+	}
+	return used
+}
+
+// selectors returns the selectors of the package-level identifiers used in
+// the Go declarations src, keyed by identifier, so that the imports of the
+// generated code can be determined.
+func selectors(src []byte) (map[string]map[string]bool, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), "", append([]byte("package p\n"), src...), 0)
+	if err != nil {
+		return nil, err
+	}
+	uses := make(map[string]map[string]bool)
+	ast.Inspect(f, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		x, ok := sel.X.(*ast.Ident)
+		if !ok || x.Obj != nil {
+			// Selectors on local identifiers are not package uses.
+			return true
+		}
+		if uses[x.Name] == nil {
+			uses[x.Name] = make(map[string]bool)
+		}
+		uses[x.Name][sel.Sel.Name] = true
+		return true
+	})
+	return uses, nil
+}
+
+// targetModel returns the data model of target, which is the name of a
+// data model or a GOOS/GOARCH pair.
+func targetModel(target string) (binding.DataModel, error) {
+	switch target {
+	case "LP64":
+		return binding.LP64, nil
+	case "ILP32":
+		return binding.ILP32, nil
+	case "LLP64":
+		return binding.LLP64, nil
+	}
+	i := strings.Index(target, "/")
+	if i < 0 {
+		return binding.DataModel{}, fmt.Errorf("invalid target %q", target)
+	}
+	return binding.ModelFor(target[:i], target[i+1:])
+}
+
+// hasBitField returns whether s has a bit field.
+func hasBitField(s binding.Struct) bool {
+	for _, f := range s.Fields {
+		if f.Bits != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// goName returns the Go name of the C function named c, or "-" if the
+// function is excluded.
+func goName(c string, cfg config) string {
+	if n, ok := cfg.Names[c]; ok {
+		return n
+	}
+	return binding.UpperCaseFirst(strings.TrimPrefix(c, cfg.Prefix))
+}
+
+// mapping holds the user-provided type mappings and the mappings of the
+// generated types. Generated types with a typedef name are keyed by that
+// name and enums without one by their tag.
+type mapping struct {
+	goTypes, cgoTypes         map[binding.TypeKey]*template.Template
+	prologues, results        map[binding.TypeKey]*template.Template
+	goGenerated, cgoGenerated map[binding.TypeKey]*template.Template
+	goEnums, cgoEnums         map[string]*template.Template
+}
+
+// generate adds the mapping of the generated type with the key k to the
+// Go type goType and the cgo conversion template cgo.
+func (m *mapping) generate(k binding.TypeKey, goType, cgo string) {
+	m.goGenerated[k] = template.Must(template.New(goType).Parse(goType))
+	m.cgoGenerated[k] = template.Must(template.New(goType).Parse(cgo))
+}
+
+// isTaggedEnum returns whether typ is a tagged enum type that is not
+// referred to by a mapped typedef name, and so is mapped by its tag.
+func (m *mapping) isTaggedEnum(typ cc.Type) bool {
+	if typ.Kind() != cc.Enum || typ.Tag() == 0 {
+		return false
+	}
+	k := binding.TypeKey{Name: binding.TypeKeyFor(typ).Name}
+	if k.Name == "" {
+		return true
+	}
+	_, user := m.goTypes[k]
+	_, generated := m.goGenerated[k]
+	return !user && !generated
+}
+
+// goType returns the Go type for the parameter p of d, or for its result
+// if p is nil.
+func (m *mapping) goType(d *binding.Declaration, p *binding.Parameter, name string) (string, error) {
+	if m.isTaggedEnum(typeOf(d, p)) {
+		return d.GoTypeForEnum(p, name, m.goEnums)
+	}
+	return d.GoTypeFor(p, name, m.goTypes, m.goGenerated)
+}
+
+// cgoConversion returns the conversion of the Go value name to the type
+// of the parameter p of d.
+func (m *mapping) cgoConversion(d *binding.Declaration, p *binding.Parameter, name string) (string, error) {
+	if m.isTaggedEnum(p.Type()) {
+		return d.CgoConversionForEnum(p, name, m.cgoEnums)
+	}
+	return d.CgoConversionFor(p, name, m.cgoTypes, m.cgoGenerated)
+}
+
+// typeOf returns the type of the parameter p of d, or of its result if p
+// is nil.
+func typeOf(d *binding.Declaration, p *binding.Parameter) cc.Type {
+	if p == nil {
+		return d.Return
+	}
+	return p.Type()
+}
+
+// writeFunc writes the Go function name wrapping the declaration d, with
+// the doc comment doc if it is not empty. Slice parameters for pointers
+// to const qualified types are documented as read and others as written,
+// and the written slices with an entry in lengths are checked before the
+// call. If the type of a parameter or the result cannot be mapped, the
+// failures are added to errs, nothing is written and writeFunc returns
+// false.
+func writeFunc(buf *bytes.Buffer, name string, d *binding.Declaration, doc []*ast.Comment, m *mapping, lengths map[string]string, errs *binding.TypeErrors) bool {
+	params := d.Parameters()
+	if len(params) == 1 && params[0].Kind() == cc.Void {
+		// f(void) has no parameters.
+		params = nil
+	}
+	names := make([]string, len(params))
+	types := make([]string, len(params))
+	convs := make([]string, len(params))
+	ok := true
+	for i := range params {
+		p := &params[i]
+		names[i] = paramName(p.Name(), i)
+		var err error
+		types[i], err = m.goType(d, p, names[i])
+		if err == nil {
+			// A parameter that cannot be mapped is reported once.
+			convs[i], err = m.cgoConversion(d, p, names[i])
+		}
+		if errs.Add(err) {
+			ok = false
+		}
+	}
+	var call bytes.Buffer
+	fmt.Fprintf(&call, "C.%s(", d.Name)
+	for i := range params {
+		if i != 0 {
+			call.WriteString(", ")
+		}
+		call.WriteString(convs[i])
+	}
+	call.WriteString(")")
+
+	hasResult := d.Return != nil && d.Return.Kind() != cc.Void
+	var result, ret string
+	if hasResult {
+		var err error
+		result, err = m.goType(d, nil, "")
+		if err == nil {
+			ret, err = d.GoResultFor(call.String(), result, m.results)
+		}
+		if errs.Add(err) {
+			ok = false
+		}
+	}
+	if !ok {
+		return false
+	}
+
+	var read, written []string
+	for i, p := range params {
+		if !strings.HasPrefix(types[i], "[]") {
+			continue
+		}
+		if p.IsConstPointer() {
+			read = append(read, names[i])
+		} else {
+			written = append(written, names[i])
+		}
+	}
+
+	if len(doc) == 0 {
+		fmt.Fprintf(buf, "// %s calls %s.\n", name, d.Name)
+	} else {
+		for _, l := range doc {
+			fmt.Fprintln(buf, l.Text)
+		}
+	}
+	if len(read)+len(written) != 0 {
+		buf.WriteString("//\n")
+		if len(read) != 0 {
+			fmt.Fprintf(buf, "// The elements of %s are only read.\n", list(read))
+		}
+		if len(written) != 0 {
+			fmt.Fprintf(buf, "// The elements of %s may be written.\n", list(written))
+		}
+	}
+
+	fmt.Fprintf(buf, "func %s(", name)
+	for i := range params {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		fmt.Fprintf(buf, "%s %s", names[i], types[i])
+	}
+	buf.WriteString(")")
+	if hasResult {
+		fmt.Fprintf(buf, " %s", result)
+	}
+	buf.WriteString(" {\n")
+
+	for i, p := range params {
+		n, ok := lengths[d.Name+"."+p.Name()]
+		if !ok {
+			continue
+		}
+		if !strings.HasPrefix(types[i], "[]") || p.IsConstPointer() {
+			log.Printf("%v: ignoring length of %s parameter %s that is not written", d.Position(), d.Name, p.Name())
+			continue
+		}
+		fmt.Fprintf(buf, "\tif len(%s) < int(%s) {\n\t\tpanic(%q)\n\t}\n", names[i], n, "short "+names[i])
+	}
+
+	for i, p := range params {
+		pro := binding.CgoPrologueFor(names[i], p.Type(), m.prologues)
+		if pro == "" {
+			continue
+		}
+		for _, l := range strings.Split(pro, "\n") {
+			fmt.Fprintf(buf, "\t%s\n", l)
+		}
+	}
+
+	buf.WriteString("\t")
+	if hasResult {
+		fmt.Fprintf(buf, "return %s", ret)
+	} else {
+		buf.Write(call.Bytes())
+	}
+	buf.WriteString("\n}\n")
+	return true
+}
+
+// list returns names joined as an English list.
+func list(names []string) string {
+	switch len(names) {
+	case 1:
+		return names[0]
+	case 2:
+		return names[0] + " and " + names[1]
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1]
+}
+
+// paramName returns a Go identifier for the C parameter name at position
+// i of a parameter list.
+func paramName(name string, i int) string {
+	switch {
+	case name == "":
+		return fmt.Sprintf("p%d", i)
+	case token.Lookup(name).IsKeyword():
+		return name + "_"
+	}
+	return name
+}
+
+// kinds maps the C type names used as config keys to their kind.
+var kinds = map[string]cc.Kind{
+	"void":                 cc.Void,
+	"char":                 cc.Char,
+	"signed char":          cc.SChar,
+	"unsigned char":        cc.UChar,
+	"short":                cc.Short,
+	"unsigned short":       cc.UShort,
+	"int":                  cc.Int,
+	"unsigned":             cc.UInt,
+	"unsigned int":         cc.UInt,
+	"long":                 cc.Long,
+	"unsigned long":        cc.ULong,
+	"long long":            cc.LongLong,
+	"unsigned long long":   cc.ULongLong,
+	"float":                cc.Float,
+	"double":               cc.Double,
+	"long double":          cc.LongDouble,
+	"_Bool":                cc.Bool,
+	"float _Complex":       cc.FloatComplex,
+	"double _Complex":      cc.DoubleComplex,
+	"long double _Complex": cc.LongDoubleComplex,
+}
+
+// typeMap returns the templates of m keyed by the TypeKey of their C
+// type. A C type that is not an arithmetic type name is taken to be a
+// typedef name.
+// mask adds an empty template to m for each key of types that m does not
+// map, so that no default mapping is used for those keys.
+func mask(m, types map[binding.TypeKey]*template.Template) {
+	for k, t := range types {
+		if _, ok := m[k]; !ok {
+			m[k] = template.Must(template.New(t.Name()).Parse(""))
+		}
+	}
+}
+
+func typeMap(m map[string]string) (map[binding.TypeKey]*template.Template, error) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	types := make(map[binding.TypeKey]*template.Template, len(m))
+	for _, c := range keys {
+		var key binding.TypeKey
+		name := strings.TrimSpace(c)
+		if strings.HasPrefix(name, "const ") {
+			key.IsConst = true
+			name = strings.TrimSpace(strings.TrimPrefix(name, "const "))
+		}
+		if strings.HasSuffix(name, "*") {
+			key.IsPointer = true
+			name = strings.TrimSpace(strings.TrimSuffix(name, "*"))
+		}
+		if k, ok := kinds[name]; ok {
+			key.Kind = k
+		} else if token.IsIdentifier(name) {
+			key.Name = name
+		} else {
+			return nil, fmt.Errorf("unknown C type %q", c)
+		}
+		t, err := template.New(c).Parse(m[c])
+		if err != nil {
+			return nil, err
+		}
+		types[key] = t
+	}
+	return types, nil
+}
@@ -0,0 +1,132 @@
+// Copyright ©2016 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/gonum/internal/binding"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+var goldenConfig = config{
+	Prefix: "golden_",
+	Names:  map[string]string{"golden_ptr": "-"},
+	Lengths: map[string]string{
+		"golden_scale.y":  "n",
+		"golden_fill.dst": "n",
+	},
+}
+
+func TestGenerate(t *testing.T) {
+	pc := binding.Config{Model: binding.LP64, NoHost: true}
+	src, err := generate("testdata/golden.h", "golden", goldenConfig, &pc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	const golden = "testdata/golden.go.golden"
+	if *update {
+		err = ioutil.WriteFile(golden, src, 0664)
+		if err != nil {
+			t.Fatalf("failed to update golden file: %v", err)
+		}
+	}
+	want, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("failed to read golden file: %v", err)
+	}
+	if !bytes.Equal(src, want) {
+		t.Errorf("unexpected output:\ngot:\n%s\nwant:\n%s", src, want)
+	}
+
+	compile(t, src)
+}
+
+// compile builds the generated source src with the golden C files,
+// skipping if cgo is not available or the host is not LP64.
+func compile(t *testing.T, src []byte) {
+	if m, err := binding.ModelFor(runtime.GOOS, runtime.GOARCH); err != nil || m != binding.LP64 {
+		t.Skip("generated code is for an LP64 host")
+	}
+	out, err := exec.Command("go", "env", "CGO_ENABLED").Output()
+	if err != nil || strings.TrimSpace(string(out)) != "1" {
+		t.Skip("cgo is not available")
+	}
+
+	dir, err := ioutil.TempDir("", "bindgen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for _, name := range []string{"golden.h", "golden.c"} {
+		b, err := ioutil.ReadFile(filepath.Join("testdata", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(filepath.Join(dir, name), b, 0664)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, "golden.go"), src, 0664)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command("go", "vet", ".")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GO111MODULE=off", "GOFLAGS=")
+	out, err = cmd.CombinedOutput()
+	if err != nil {
+		t.Errorf("generated code does not build: %v\n%s", err, out)
+	}
+}
+
+func TestGenerateResult(t *testing.T) {
+	pc := binding.Config{Model: binding.LP64, NoHost: true}
+	cfg := goldenConfig
+	cfg.Names = nil
+	_, err := generate("testdata/golden.h", "golden", cfg, &pc)
+	errs, ok := err.(binding.TypeErrors)
+	if !ok {
+		t.Fatalf("expected type errors for pointer result, got: %v", err)
+	}
+	if len(errs) != 1 || errs[0].Decl != "golden_ptr" || errs[0].Param != "" {
+		t.Errorf("unexpected errors:\n%v", errs)
+	}
+}
+
+func TestGenerateUserChar(t *testing.T) {
+	pc := binding.Config{Model: binding.LP64, NoHost: true}
+	cfg := goldenConfig
+	cfg.GoTypes = map[string]string{"char*": "[]byte"}
+	cfg.CgoTypes = map[string]string{"char*": "(*C.char)(unsafe.Pointer(&{{.}}[0]))"}
+	cfg.Results = map[string]string{"const char*": "[]byte(C.GoString({{.}}))"}
+	src, err := generate("testdata/golden.h", "golden", cfg, &pc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{
+		"func Name(prefix []byte) []byte {",
+		"return []byte(C.GoString(C.golden_name((*C.char)(unsafe.Pointer(&prefix[0])))))",
+	} {
+		if !bytes.Contains(src, []byte(want)) {
+			t.Errorf("missing %q in output:\n%s", want, src)
+		}
+	}
+	if bytes.Contains(src, []byte("C.CString")) {
+		t.Errorf("unexpected default prologue in output:\n%s", src)
+	}
+}
@@ -0,0 +1,33 @@
+#include "golden.h"
+
+golden_int golden_scale(golden_order order, enum golden_side side, golden_int n, const double *x, double *y) {
+	golden_int i;
+	for (i = 0; i < n; i++) {
+		y[i] = order == RowMajor && side == Left ? 2 * x[i] : x[i];
+	}
+	return n;
+}
+
+double golden_norm(const golden_opts *opts, const double *x) {
+	double s = 0;
+	golden_int i;
+	for (i = 0; i < opts->n; i++) {
+		s += opts->scale * x[i] * x[i];
+	}
+	return s;
+}
+
+const char *golden_name(const char *prefix) {
+	return prefix;
+}
+
+void golden_fill(unsigned *dst, size_t n, unsigned v) {
+	size_t i;
+	for (i = 0; i < n; i++) {
+		dst[i] = v;
+	}
+}
+
+double *golden_ptr(double *x) {
+	return x;
+}
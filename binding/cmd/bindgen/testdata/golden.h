@@ -0,0 +1,27 @@
+#include <stddef.h>
+
+#define GOLDEN_MAX 64
+#define GOLDEN_EPS 1e-8
+
+typedef enum {
+	RowMajor = 101,
+	ColMajor = 102
+} golden_order;
+
+enum golden_side {
+	Left = 141,
+	Right = 142
+};
+
+typedef int golden_int;
+
+typedef struct {
+	golden_int n;
+	double scale;
+} golden_opts;
+
+golden_int golden_scale(golden_order order, enum golden_side side, golden_int n, const double *x, double *y);
+double golden_norm(const golden_opts *opts, const double *x);
+const char *golden_name(const char *prefix);
+void golden_fill(unsigned *dst, size_t n, unsigned v);
+double *golden_ptr(double *x);
@@ -0,0 +1,304 @@
+// Copyright ©2016 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package binding
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"strings"
+	"unsafe"
+
+	"github.com/cznic/cc"
+)
+
+// Config is the configuration used to parse C files.
+type Config struct {
+	// Model is the data model of the target the bindings are
+	// generated for. If Model is the zero DataModel, the data
+	// model returned by ModelFor for the host is used.
+	Model DataModel
+
+	// IncludePaths and SysIncludePaths are the directories
+	// searched for quoted and angle-bracketed includes, before
+	// those of the host.
+	IncludePaths    []string
+	SysIncludePaths []string
+
+	// Defines are macro definitions in the form of the -D flag
+	// of a C compiler, NAME or NAME=VALUE. A definition without
+	// a value defines the macro as 1.
+	Defines []string
+
+	// Predefined is the source parsed before the files,
+	// following the predefined macros of the host and the data
+	// model and preceding the Defines. If Predefined is empty,
+	// DefaultPredefined is used unless NoDefaultPredefined is
+	// set.
+	Predefined          string
+	NoDefaultPredefined bool
+
+	// NoHost specifies that no host C compiler is used. The
+	// predefined macros are those of the standard and the data
+	// model, and system headers are found in a minimal libc
+	// header set bundled with the package, which declares the
+	// types of the standard headers but few functions. The
+	// headers are written once to a directory in os.TempDir
+	// that is kept, so that positions in them remain valid.
+	NoHost bool
+}
+
+// DefaultPredefined is the default source parsed before the files. It
+// hides the GCC extensions used by common system headers.
+const DefaultPredefined = `#define __const const
+#define __attribute__(...)
+#define __extension__
+#define __inline
+#define __restrict
+unsigned __builtin_bswap32 (unsigned x);
+unsigned long long __builtin_bswap64 (unsigned long long x);
+`
+
+// DataModel is a C data model, giving the sizes and alignments in bytes
+// of the C types that differ between targets. The other integer and
+// floating point types have their usual sizes, with char being 8 bits,
+// short 16 bits, int 32 bits and long long 64 bits.
+type DataModel struct {
+	// Pointer and Long are the sizes of pointers and long.
+	Pointer int
+	Long    int
+
+	// LongDouble and LongDoubleAlign are the size and alignment
+	// of long double.
+	LongDouble      int
+	LongDoubleAlign int
+
+	// StructAlign8 is the alignment of long long and double
+	// fields of a struct.
+	StructAlign8 int
+}
+
+var (
+	// ILP32 is the data model of 32-bit targets, with 32-bit int,
+	// long and pointers.
+	ILP32 = DataModel{Pointer: 4, Long: 4, LongDouble: 8, LongDoubleAlign: 8, StructAlign8: 8}
+
+	// LP64 is the data model of 64-bit Unix targets, with 64-bit
+	// long and pointers.
+	LP64 = DataModel{Pointer: 8, Long: 8, LongDouble: 16, LongDoubleAlign: 16, StructAlign8: 8}
+
+	// LLP64 is the data model of 64-bit Windows targets, with
+	// 32-bit long and 64-bit pointers.
+	LLP64 = DataModel{Pointer: 8, Long: 4, LongDouble: 8, LongDoubleAlign: 8, StructAlign8: 8}
+)
+
+// ModelFor returns the data model of the C ABI of the GOOS and GOARCH
+// pair goos and goarch. Targets whose data model cannot be described to
+// the parser, such as linux/386 with its 12-byte long double, are
+// reported as unsupported.
+func ModelFor(goos, goarch string) (DataModel, error) {
+	switch goarch {
+	case "386":
+		if goos == "windows" {
+			return ILP32, nil
+		}
+		// The System V i386 ABI pads the 80-bit long double to
+		// 12 bytes, which the parser's model cannot describe.
+		return DataModel{}, fmt.Errorf("binding: long double of %s/%s is not supported", goos, goarch)
+	case "arm", "mips", "mipsle":
+		return ILP32, nil
+	case "amd64", "arm64":
+		if goos == "windows" {
+			return LLP64, nil
+		}
+		if goarch == "arm64" && (goos == "darwin" || goos == "ios") {
+			// Apple platforms make long double a double.
+			m := LP64
+			m.LongDouble, m.LongDoubleAlign = 8, 8
+			return m, nil
+		}
+		return LP64, nil
+	case "mips64", "mips64le", "ppc64", "ppc64le", "riscv64", "loong64":
+		return LP64, nil
+	case "s390x":
+		m := LP64
+		m.LongDoubleAlign = 8
+		return m, nil
+	}
+	return DataModel{}, fmt.Errorf("binding: no data model for %s/%s", goos, goarch)
+}
+
+// hostModel returns the data model of the host.
+func hostModel() DataModel {
+	m, err := ModelFor(runtime.GOOS, runtime.GOARCH)
+	if err == nil {
+		return m
+	}
+	p := int(unsafe.Sizeof(uintptr(0)))
+	return DataModel{
+		Pointer:         p,
+		Long:            int(unsafe.Sizeof(int(0))),
+		LongDouble:      8,
+		LongDoubleAlign: 8,
+		StructAlign8:    8,
+	}
+}
+
+// model returns the cc model for m.
+func (m DataModel) model() *cc.Model {
+	p := m.Pointer
+	l := m.Long
+	a8 := m.StructAlign8
+	ld := m.LongDouble
+	lda := m.LongDoubleAlign
+	return &cc.Model{
+		Items: map[cc.Kind]cc.ModelItem{
+			cc.Ptr:               {Size: p, Align: p, StructAlign: p},
+			cc.UintPtr:           {Size: p, Align: p, StructAlign: p},
+			cc.Void:              {Size: 0, Align: 1, StructAlign: 1},
+			cc.Char:              {Size: 1, Align: 1, StructAlign: 1},
+			cc.SChar:             {Size: 1, Align: 1, StructAlign: 1},
+			cc.UChar:             {Size: 1, Align: 1, StructAlign: 1},
+			cc.Short:             {Size: 2, Align: 2, StructAlign: 2},
+			cc.UShort:            {Size: 2, Align: 2, StructAlign: 2},
+			cc.Int:               {Size: 4, Align: 4, StructAlign: 4},
+			cc.UInt:              {Size: 4, Align: 4, StructAlign: 4},
+			cc.Long:              {Size: l, Align: l, StructAlign: l},
+			cc.ULong:             {Size: l, Align: l, StructAlign: l},
+			cc.LongLong:          {Size: 8, Align: 8, StructAlign: a8},
+			cc.ULongLong:         {Size: 8, Align: 8, StructAlign: a8},
+			cc.Float:             {Size: 4, Align: 4, StructAlign: 4},
+			cc.Double:            {Size: 8, Align: 8, StructAlign: a8},
+			cc.LongDouble:        {Size: ld, Align: lda, StructAlign: lda},
+			cc.Bool:              {Size: 1, Align: 1, StructAlign: 1},
+			cc.FloatComplex:      {Size: 8, Align: 8, StructAlign: 4},
+			cc.DoubleComplex:     {Size: 16, Align: 8, StructAlign: a8},
+			cc.LongDoubleComplex: {Size: 2 * ld, Align: lda, StructAlign: lda},
+		},
+	}
+}
+
+// predefined returns the macro definitions that describe m to the
+// preprocessor, replacing those of the host.
+func (m DataModel) predefined() string {
+	size, diff := "unsigned long", "long"
+	switch {
+	case m.Pointer == 4:
+		size, diff = "unsigned int", "int"
+	case m.Pointer > m.Long:
+		size, diff = "unsigned long long", "long long"
+	}
+	long := "2147483647L"
+	if m.Long == 8 {
+		long = "9223372036854775807L"
+	}
+	defs := []struct{ name, value string }{
+		{"__SIZEOF_POINTER__", fmt.Sprint(m.Pointer)},
+		{"__SIZEOF_LONG__", fmt.Sprint(m.Long)},
+		{"__SIZEOF_LONG_DOUBLE__", fmt.Sprint(m.LongDouble)},
+		{"__SIZEOF_SIZE_T__", fmt.Sprint(m.Pointer)},
+		{"__SIZEOF_PTRDIFF_T__", fmt.Sprint(m.Pointer)},
+		{"__LONG_MAX__", long},
+		{"__SIZE_TYPE__", size},
+		{"__PTRDIFF_TYPE__", diff},
+		{"__INTPTR_TYPE__", diff},
+		{"__UINTPTR_TYPE__", size},
+	}
+
+	var buf bytes.Buffer
+	for _, name := range []string{"__LP64__", "_LP64", "__ILP32__", "_ILP32"} {
+		fmt.Fprintf(&buf, "#undef %s\n", name)
+	}
+	switch {
+	case m.Pointer == 8 && m.Long == 8:
+		buf.WriteString("#define __LP64__ 1\n#define _LP64 1\n")
+	case m.Pointer == 4:
+		buf.WriteString("#define __ILP32__ 1\n#define _ILP32 1\n")
+	}
+	for _, d := range defs {
+		fmt.Fprintf(&buf, "#undef %s\n#define %[1]s %s\n", d.name, d.value)
+	}
+	return buf.String()
+}
+
+// standardPredefined holds the macro definitions of the standard that a
+// C compiler predefines independently of the data model.
+const standardPredefined = `#define __STDC__ 1
+#define __STDC_VERSION__ 199901L
+#define __STDC_HOSTED__ 1
+#define __CHAR_BIT__ 8
+#define __SCHAR_MAX__ 127
+#define __SHRT_MAX__ 32767
+#define __INT_MAX__ 2147483647
+#define __LONG_LONG_MAX__ 9223372036854775807LL
+#define __WCHAR_TYPE__ int
+`
+
+// defines returns the macro definitions of c.Defines.
+func (c *Config) defines() string {
+	var buf bytes.Buffer
+	for _, d := range c.Defines {
+		name, value := d, "1"
+		if i := strings.Index(d, "="); i >= 0 {
+			name, value = d[:i], d[i+1:]
+		}
+		fmt.Fprintf(&buf, "#define %s %s\n", name, value)
+	}
+	return buf.String()
+}
+
+// parse returns the translation unit of the given set of file paths,
+// parsed with the configuration c. If c has a data model, the host's
+// predefined data model macros are replaced by those of the target,
+// but architecture and operating system macros are those of the host
+// unless c.NoHost is set.
+func (c *Config) parse(paths []string) (*cc.TranslationUnit, error) {
+	m := c.Model
+	if m == (DataModel{}) {
+		m = hostModel()
+	}
+
+	var (
+		predefined      string
+		includePaths    []string
+		sysIncludePaths []string
+		err             error
+	)
+	if c.NoHost {
+		var dir string
+		dir, err = libcDir()
+		if err != nil {
+			return nil, fmt.Errorf("binding: failed to write libc headers: %v", err)
+		}
+		predefined = standardPredefined + m.predefined()
+		sysIncludePaths = []string{dir}
+	} else {
+		predefined, includePaths, sysIncludePaths, err = cc.HostConfig()
+		if err != nil {
+			return nil, fmt.Errorf("binding: failed to get host config: %v", err)
+		}
+		if c.Model != (DataModel{}) {
+			predefined += "\n" + m.predefined()
+		}
+	}
+
+	p := c.Predefined
+	if p == "" && !c.NoDefaultPredefined {
+		p = DefaultPredefined
+	}
+	predefined += "\n" + p + "\n" + c.defines()
+
+	t, err := cc.Parse(
+		predefined,
+		paths,
+		m.model(),
+		cc.IncludePaths(append(c.IncludePaths[:len(c.IncludePaths):len(c.IncludePaths)], includePaths...)),
+		cc.SysIncludePaths(append(c.SysIncludePaths[:len(c.SysIncludePaths):len(c.SysIncludePaths)], sysIncludePaths...)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("binding: failed to parse %q: %v", paths, err)
+	}
+	return t, nil
+}
@@ -0,0 +1,180 @@
+// Copyright ©2016 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package binding
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cznic/cc"
+)
+
+// requireHost skips the test if there is no host C compiler.
+func requireHost(t *testing.T) {
+	if _, _, _, err := cc.HostConfig(); err != nil {
+		t.Skipf("no host C compiler: %v", err)
+	}
+}
+
+func TestModelFor(t *testing.T) {
+	for _, test := range []struct {
+		goos, goarch string
+		want         DataModel
+		err          bool
+	}{
+		{goos: "linux", goarch: "amd64", want: LP64},
+		{goos: "windows", goarch: "amd64", want: LLP64},
+		{goos: "windows", goarch: "386", want: ILP32},
+		{goos: "linux", goarch: "arm", want: ILP32},
+		{goos: "linux", goarch: "386", err: true},
+		{goos: "plan9", goarch: "sparc", err: true},
+	} {
+		got, err := ModelFor(test.goos, test.goarch)
+		if (err != nil) != test.err {
+			t.Errorf("unexpected error for %s/%s: %v", test.goos, test.goarch, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("unexpected model for %s/%s: got %+v want %+v", test.goos, test.goarch, got, test.want)
+		}
+	}
+}
+
+func TestDataModel(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		model DataModel
+		want  map[string]int
+	}{
+		{name: "LP64", model: LP64, want: map[string]int{"sizes": 16, "ptr": 16, "dbl": 16}},
+		{name: "ILP32", model: ILP32, want: map[string]int{"sizes": 8, "ptr": 8, "dbl": 16}},
+		{name: "LLP64", model: LLP64, want: map[string]int{"sizes": 16, "ptr": 16, "dbl": 16}},
+	} {
+		for _, noHost := range []bool{false, true} {
+			if !noHost {
+				requireHost(t)
+			}
+			c := Config{Model: test.model, NoHost: noHost}
+			structs, err := c.Structs("testdata/model.h")
+			if err != nil {
+				t.Fatalf("unexpected error for %s (nohost=%t): %v", test.name, noHost, err)
+			}
+			got := make(map[string]int)
+			for _, s := range structs {
+				got[s.Tag] = s.Size
+			}
+			for tag, size := range test.want {
+				if got[tag] != size {
+					t.Errorf("unexpected size of struct %s for %s (nohost=%t): got %d want %d", tag, test.name, noHost, got[tag], size)
+				}
+			}
+		}
+	}
+}
+
+func TestConfig(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		c    Config
+		want map[string]int64
+		err  bool
+	}{
+		{
+			name: "no include path",
+			c:    Config{NoHost: true},
+			err:  true,
+		},
+		{
+			name: "include path",
+			c:    Config{NoHost: true, IncludePaths: []string{"testdata/include"}},
+			want: map[string]int64{"CONFIG_INC": 3, "CONFIG_DEFINE": 0, "CONFIG_PREDEFINE": 0, "CONFIG_DEFAULT": 1},
+		},
+		{
+			name: "defines",
+			c: Config{
+				NoHost:       true,
+				IncludePaths: []string{"testdata/include"},
+				Defines:      []string{"CONFIG_DEFINED=7"},
+			},
+			want: map[string]int64{"CONFIG_DEFINE": 7, "CONFIG_PREDEFINE": 0, "CONFIG_DEFAULT": 1},
+		},
+		{
+			name: "define without value",
+			c: Config{
+				NoHost:       true,
+				IncludePaths: []string{"testdata/include"},
+				Defines:      []string{"CONFIG_DEFINED"},
+			},
+			want: map[string]int64{"CONFIG_DEFINE": 1},
+		},
+		{
+			name: "predefined",
+			c: Config{
+				NoHost:       true,
+				IncludePaths: []string{"testdata/include"},
+				Predefined:   "#define CONFIG_PREDEFINED 5\n",
+			},
+			want: map[string]int64{"CONFIG_DEFINE": 0, "CONFIG_PREDEFINE": 5, "CONFIG_DEFAULT": 0},
+		},
+		{
+			name: "no default predefined",
+			c: Config{
+				NoHost:              true,
+				IncludePaths:        []string{"testdata/include"},
+				NoDefaultPredefined: true,
+			},
+			want: map[string]int64{"CONFIG_DEFINE": 0, "CONFIG_PREDEFINE": 0, "CONFIG_DEFAULT": 0},
+		},
+	} {
+		macros, err := test.c.Macros("testdata/config.h")
+		if test.err {
+			if err == nil {
+				t.Errorf("expected error for %s", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", test.name, err)
+			continue
+		}
+		got := make(map[string]interface{})
+		for _, m := range macros {
+			got[m.Name] = m.Value
+		}
+		for name, v := range test.want {
+			if got[name] != v {
+				t.Errorf("unexpected value of %s for %s: got %v want %d", name, test.name, got[name], v)
+			}
+		}
+	}
+}
+
+func TestNoHostPositions(t *testing.T) {
+	c := Config{NoHost: true, IncludePaths: []string{"testdata/include"}}
+	var dir string
+	for i := 0; i < 2; i++ {
+		decls, err := c.Declarations("testdata/config.h")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var libc string
+		for _, d := range decls {
+			if d.Name == "malloc" {
+				libc = d.Position().Filename
+			}
+		}
+		if libc == "" {
+			t.Fatal("no declaration of malloc")
+		}
+		if _, err := os.Stat(libc); err != nil {
+			t.Errorf("libc header of malloc does not exist: %v", err)
+		}
+		if i != 0 && filepath.Dir(libc) != dir {
+			t.Errorf("libc headers moved: got %s want %s", filepath.Dir(libc), dir)
+		}
+		dir = filepath.Dir(libc)
+	}
+}
@@ -0,0 +1,223 @@
+// Copyright ©2016 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package binding
+
+import (
+	"bytes"
+	"fmt"
+	"go/token"
+
+	"github.com/cznic/cc"
+	"github.com/cznic/xc"
+)
+
+// Enum is a description of a C enum declaration.
+type Enum struct {
+	Pos token.Pos
+
+	// Tag is the enum tag and Name is the typedef name of
+	// the type. Either may be empty, but not both.
+	Tag  string
+	Name string
+
+	Type cc.Type
+
+	Values []EnumValue
+}
+
+// Position returns the token position of the declaration.
+func (e *Enum) Position() token.Position { return xc.FileSet.Position(e.Pos) }
+
+// GoName returns the name of the Go type for the enum, as described by
+// TypeName.
+func (e *Enum) GoName() string { return TypeName(e.Tag, e.Name) }
+
+// CName returns the cgo name of the C type, C.<typedef name> if it has
+// a typedef name and otherwise C.enum_<tag>.
+func (e *Enum) CName() string {
+	if e.Name != "" {
+		return "C." + e.Name
+	}
+	return "C.enum_" + e.Tag
+}
+
+// EnumValue is an enumerator of a C enum.
+type EnumValue struct {
+	Pos   token.Pos
+	Name  string
+	Value int64
+}
+
+// Position returns the token position of the enumerator.
+func (v *EnumValue) Position() token.Position { return xc.FileSet.Position(v.Pos) }
+
+// Enums returns the C enum declarations in the given set of file paths,
+// parsed with the zero Config, as described by the Enums method.
+func Enums(paths ...string) ([]Enum, error) {
+	var c Config
+	return c.Enums(paths...)
+}
+
+// Enums returns the C enum declarations in the given set of file paths
+// that are named by a typedef or used by a function declaration, a
+// variable declaration or the field of a struct returned by Structs.
+// The enumerators are in declaration order and the files are parsed with
+// the configuration c.
+func (c *Config) Enums(paths ...string) ([]Enum, error) {
+	t, err := c.parse(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	var enums []Enum
+	seen := make(map[interface{}]int)
+	visited := make(map[interface{}]bool)
+	var add func(typ cc.Type, name string, pos token.Pos)
+	add = func(typ cc.Type, name string, pos token.Pos) {
+		if typ == nil {
+			return
+		}
+		if n := typedefName(typ.Declarator()); n != "" {
+			name = n
+		}
+		for typ.Kind() == cc.Ptr || typ.Kind() == cc.Array {
+			typ = typ.Element()
+		}
+		switch typ.Kind() {
+		case cc.Struct, cc.Union:
+			key := typeID(typ)
+			if visited[key] {
+				return
+			}
+			visited[key] = true
+			members, _ := typ.Members()
+			for _, m := range members {
+				add(m.Type, "", pos)
+			}
+			return
+		case cc.Enum:
+		default:
+			return
+		}
+
+		var tag string
+		if typ.Tag() != 0 {
+			tag = string(xc.Dict.S(typ.Tag()))
+		}
+		if tag == "" && name == "" {
+			// We cannot name an anonymous enum.
+			return
+		}
+
+		key := typeID(typ)
+		if i, ok := seen[key]; ok {
+			if enums[i].Name == "" {
+				enums[i].Name = name
+			}
+			return
+		}
+		seen[key] = len(enums)
+
+		enums = append(enums, Enum{
+			Pos:    pos,
+			Tag:    tag,
+			Name:   name,
+			Type:   typ,
+			Values: enumerators(typ),
+		})
+	}
+
+	walkTypes(t, add)
+
+	return enums, nil
+}
+
+// enumerators returns the enumerators of the enum type typ. A reference
+// to a tagged enum type carries no enumerators, so they are taken from
+// the enum specifier that declares the tag.
+func enumerators(typ cc.Type) []EnumValue {
+	var values []EnumValue
+	for _, c := range typ.EnumeratorList() {
+		values = append(values, EnumValue{
+			Pos:   c.DefTok.Pos(),
+			Name:  string(c.DefTok.S()),
+			Value: enumValue(c.Value),
+		})
+	}
+	if values != nil || typ.Tag() == 0 {
+		return values
+	}
+
+	_, scope := typ.Declarator().Identifier()
+	if scope == nil {
+		return nil
+	}
+	es, ok := scope.Lookup(cc.NSTags, typ.Tag()).Node.(*cc.EnumSpecifier)
+	if !ok {
+		return nil
+	}
+	for l := es.EnumeratorList; l != nil; l = l.EnumeratorList {
+		tok := l.Enumerator.EnumerationConstant.Token
+		values = append(values, EnumValue{
+			Pos:   tok.Pos(),
+			Name:  string(tok.S()),
+			Value: enumValue(l.Enumerator.Value),
+		})
+	}
+	return values
+}
+
+// enumValue returns the integer value of an enumerator constant.
+func enumValue(v interface{}) int64 {
+	switch v := v.(type) {
+	case int32:
+		return int64(v)
+	case int64:
+		return v
+	case uint32:
+		return int64(v)
+	case uint64:
+		return int64(v)
+	case int:
+		return int64(v)
+	}
+	panic(fmt.Sprintf("binding: unexpected enumerator value: %T", v))
+}
+
+// GoEnumFor returns the source of a Go type definition for e named name,
+// or e.GoName() if name is empty, with the integer type of the same size
+// as its underlying type, a block of typed constants with the names and
+// values of the enumerators of e and a String method returning the name
+// of the constant for a value. Where enumerators share a value, String
+// returns the name of the first. The generated String method uses fmt.
+func GoEnumFor(e *Enum, name string) string {
+	if name == "" {
+		name = e.GoName()
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "type %s %s\n\n", name, layoutType(e.Type))
+
+	buf.WriteString("const (\n")
+	for _, v := range e.Values {
+		fmt.Fprintf(&buf, "\t%s %s = %d\n", UpperCaseFirst(v.Name), name, v.Value)
+	}
+	buf.WriteString(")\n\n")
+
+	fmt.Fprintf(&buf, "func (e %s) String() string {\n", name)
+	buf.WriteString("\tswitch e {\n")
+	seen := make(map[int64]bool)
+	for _, v := range e.Values {
+		if seen[v.Value] {
+			continue
+		}
+		seen[v.Value] = true
+		fmt.Fprintf(&buf, "\tcase %s:\n\t\treturn %q\n", UpperCaseFirst(v.Name), v.Name)
+	}
+	buf.WriteString("\t}\n")
+	fmt.Fprintf(&buf, "\treturn fmt.Sprintf(\"%s(%%d)\", e)\n", name)
+	buf.WriteString("}\n")
+	return buf.String()
+}
@@ -0,0 +1,111 @@
+// Copyright ©2016 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package binding
+
+import (
+	"reflect"
+	"testing"
+	"text/template"
+)
+
+func TestEnums(t *testing.T) {
+	requireHost(t)
+	enums, err := Enums("testdata/enum.h")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	type value struct {
+		name  string
+		value int64
+	}
+	want := []struct {
+		tag, name, goName, cName string
+		values                   []value
+	}{
+		{
+			name: "CBLAS_ORDER", goName: "CBLAS_ORDER", cName: "C.CBLAS_ORDER",
+			values: []value{{"RowMajor", 101}, {"ColMajor", 102}},
+		},
+		{
+			tag: "tagged", goName: "Tagged", cName: "C.enum_tagged",
+			values: []value{{"A", 1}, {"B", 2}, {"C", 2}},
+		},
+	}
+	if len(enums) != len(want) {
+		t.Fatalf("unexpected number of enums: got %d want %d", len(enums), len(want))
+	}
+	for i, e := range enums {
+		w := want[i]
+		if e.Tag != w.tag || e.Name != w.name {
+			t.Errorf("unexpected enum %d: got tag %q name %q want tag %q name %q", i, e.Tag, e.Name, w.tag, w.name)
+		}
+		if got := e.GoName(); got != w.goName {
+			t.Errorf("unexpected Go name for %s: got %q want %q", e.Type, got, w.goName)
+		}
+		if got := e.CName(); got != w.cName {
+			t.Errorf("unexpected cgo name for %s: got %q want %q", e.Type, got, w.cName)
+		}
+		var got []value
+		for _, v := range e.Values {
+			got = append(got, value{v.Name, v.Value})
+		}
+		if !reflect.DeepEqual(got, w.values) {
+			t.Errorf("unexpected values for %s: got %v want %v", e.Type, got, w.values)
+		}
+	}
+
+	const wantTagged = `type Tagged int32
+
+const (
+	A Tagged = 1
+	B Tagged = 2
+	C Tagged = 2
+)
+
+func (e Tagged) String() string {
+	switch e {
+	case A:
+		return "A"
+	case B:
+		return "B"
+	}
+	return fmt.Sprintf("Tagged(%d)", e)
+}
+`
+	if got := GoEnumFor(&enums[1], ""); got != wantTagged {
+		t.Errorf("unexpected Go enum for tagged:\ngot:\n%s\nwant:\n%s", got, wantTagged)
+	}
+}
+
+func TestEnumParameters(t *testing.T) {
+	d := declaration(t, "testdata/enum.h", "enum_func")
+	params := d.Parameters()
+
+	types := map[TypeKey]*template.Template{
+		{Name: "CBLAS_ORDER"}: template.Must(template.New("CBLAS_ORDER").Parse("Order")),
+	}
+	got, err := d.GoTypeFor(&params[0], "o", types)
+	if err != nil {
+		t.Errorf("unexpected error for typedef'd enum: %v", err)
+	}
+	if got != "Order" {
+		t.Errorf("unexpected Go type for typedef'd enum: got %q want %q", got, "Order")
+	}
+
+	enums := map[string]*template.Template{
+		"tagged": template.Must(template.New("tagged").Parse("Tagged")),
+	}
+	got, err = d.GoTypeForEnum(&params[1], "t", enums)
+	if err != nil {
+		t.Errorf("unexpected error for tagged enum: %v", err)
+	}
+	if got != "Tagged" {
+		t.Errorf("unexpected Go type for tagged enum: got %q want %q", got, "Tagged")
+	}
+	if _, err = d.GoTypeForEnum(&params[0], "o", enums); err == nil {
+		t.Error("expected error for untagged enum mapped by tag")
+	}
+}
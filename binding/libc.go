@@ -0,0 +1,182 @@
+// Copyright ©2016 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package binding
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// libc is the minimal libc header set used when parsing without a host C
+// compiler. The types depend on the macros defined by DataModel.predefined.
+var libc = map[string]string{
+	"stddef.h": `#ifndef _STDDEF_H
+#define _STDDEF_H
+typedef __SIZE_TYPE__ size_t;
+typedef __PTRDIFF_TYPE__ ptrdiff_t;
+typedef __WCHAR_TYPE__ wchar_t;
+#define NULL ((void*)0)
+#define offsetof(t, m) ((size_t)&((t*)0)->m)
+#endif
+`,
+	"stdint.h": `#ifndef _STDINT_H
+#define _STDINT_H
+typedef signed char int8_t;
+typedef short int16_t;
+typedef int int32_t;
+typedef long long int64_t;
+typedef unsigned char uint8_t;
+typedef unsigned short uint16_t;
+typedef unsigned int uint32_t;
+typedef unsigned long long uint64_t;
+typedef __INTPTR_TYPE__ intptr_t;
+typedef __UINTPTR_TYPE__ uintptr_t;
+typedef long long intmax_t;
+typedef unsigned long long uintmax_t;
+#define INT8_MAX 127
+#define INT16_MAX 32767
+#define INT32_MAX 2147483647
+#define INT64_MAX 9223372036854775807LL
+#define UINT8_MAX 255
+#define UINT16_MAX 65535
+#define UINT32_MAX 4294967295U
+#define UINT64_MAX 18446744073709551615ULL
+#endif
+`,
+	"inttypes.h": `#ifndef _INTTYPES_H
+#define _INTTYPES_H
+#include <stdint.h>
+#endif
+`,
+	"stdbool.h": `#ifndef _STDBOOL_H
+#define _STDBOOL_H
+#define bool _Bool
+#define true 1
+#define false 0
+#endif
+`,
+	"stdarg.h": `#ifndef _STDARG_H
+#define _STDARG_H
+typedef void *va_list;
+#endif
+`,
+	"limits.h": `#ifndef _LIMITS_H
+#define _LIMITS_H
+#define CHAR_BIT __CHAR_BIT__
+#define SCHAR_MAX __SCHAR_MAX__
+#define SCHAR_MIN (-SCHAR_MAX - 1)
+#define UCHAR_MAX 255
+#define CHAR_MAX SCHAR_MAX
+#define CHAR_MIN SCHAR_MIN
+#define SHRT_MAX __SHRT_MAX__
+#define SHRT_MIN (-SHRT_MAX - 1)
+#define USHRT_MAX 65535
+#define INT_MAX __INT_MAX__
+#define INT_MIN (-INT_MAX - 1)
+#define UINT_MAX 4294967295U
+#define LONG_MAX __LONG_MAX__
+#define LONG_MIN (-LONG_MAX - 1L)
+#define LLONG_MAX __LONG_LONG_MAX__
+#define LLONG_MIN (-LLONG_MAX - 1LL)
+#define ULLONG_MAX 18446744073709551615ULL
+#endif
+`,
+	"complex.h": `#ifndef _COMPLEX_H
+#define _COMPLEX_H
+#define complex _Complex
+#endif
+`,
+	"stdlib.h": `#ifndef _STDLIB_H
+#define _STDLIB_H
+#include <stddef.h>
+void *malloc(size_t size);
+void *calloc(size_t n, size_t size);
+void free(void *ptr);
+#endif
+`,
+	"string.h": `#ifndef _STRING_H
+#define _STRING_H
+#include <stddef.h>
+#endif
+`,
+	"stdio.h": `#ifndef _STDIO_H
+#define _STDIO_H
+#include <stddef.h>
+typedef struct _FILE FILE;
+#define EOF (-1)
+#endif
+`,
+}
+
+var (
+	libcOnce sync.Once
+	libcPath string
+	libcErr  error
+)
+
+// libcDir returns the path of a directory holding the libc header set. The
+// directory is named by the hash of the headers and is written once and
+// kept, so that the positions of parsed declarations refer to files that
+// exist.
+func libcDir() (string, error) {
+	libcOnce.Do(func() {
+		libcPath, libcErr = writeLibc(os.TempDir())
+	})
+	return libcPath, libcErr
+}
+
+// writeLibc writes the libc header set to a directory in root named by the
+// hash of the headers, if the files are not already there, and returns its
+// path.
+func writeLibc(root string) (dir string, err error) {
+	names := make([]string, 0, len(libc))
+	for name := range libc {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	h := sha256.New()
+	for _, name := range names {
+		fmt.Fprintf(h, "%s\x00%s\x00", name, libc[name])
+	}
+	dir = filepath.Join(root, fmt.Sprintf("gonum-binding-libc-%x", h.Sum(nil)[:8]))
+
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		return "", err
+	}
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		b, err := ioutil.ReadFile(path)
+		if err == nil && string(b) == libc[name] {
+			continue
+		}
+		// Write to a temporary file and rename it so that
+		// concurrent parses never read a partial header.
+		f, err := ioutil.TempFile(dir, name)
+		if err != nil {
+			return "", err
+		}
+		_, err = f.WriteString(libc[name])
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err == nil {
+			err = os.Chmod(f.Name(), 0644)
+		}
+		if err == nil {
+			err = os.Rename(f.Name(), path)
+		}
+		if err != nil {
+			os.Remove(f.Name())
+			return "", err
+		}
+	}
+	return dir, nil
+}
@@ -0,0 +1,154 @@
+// Copyright ©2016 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package binding
+
+import (
+	"fmt"
+	"go/token"
+	"math"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cznic/cc"
+	"github.com/cznic/xc"
+)
+
+// Macro is a description of an object-like C macro that expands to a
+// constant.
+type Macro struct {
+	Pos  token.Pos
+	Name string
+
+	// Value is the value of the expansion. It is an int64 for
+	// signed integer constants, a uint64 for unsigned integer
+	// constants, a float64 for floating point constants and a
+	// string for string literals.
+	Value interface{}
+}
+
+// Position returns the token position of the macro definition.
+func (m *Macro) Position() token.Position { return xc.FileSet.Position(m.Pos) }
+
+// Macros returns the constant object-like macros defined in the given set
+// of file paths, parsed with the zero Config, as described by the Macros
+// method.
+func Macros(paths ...string) ([]Macro, error) {
+	var c Config
+	return c.Macros(paths...)
+}
+
+// Macros returns the object-like macros defined in the given set of file
+// paths whose expansion is an integer, floating point or string constant
+// expression, sorted by position. Macros defined by included files and
+// by the parser configuration are not returned. The files are parsed with
+// the configuration c.
+func (c *Config) Macros(paths ...string) ([]Macro, error) {
+	t, err := c.parse(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string]bool)
+	for _, p := range paths {
+		abs, err := filepath.Abs(p)
+		if err != nil {
+			return nil, fmt.Errorf("binding: %v", err)
+		}
+		files[abs] = true
+	}
+
+	var macros []Macro
+	for _, m := range t.Macros {
+		if m.IsFnLike || m.Value == nil {
+			continue
+		}
+		pos := m.DefTok.Pos()
+		abs, err := filepath.Abs(xc.FileSet.Position(pos).Filename)
+		if err != nil || !files[abs] {
+			continue
+		}
+		v, ok := macroValue(m.Value)
+		if !ok {
+			continue
+		}
+		macros = append(macros, Macro{
+			Pos:   pos,
+			Name:  string(m.DefTok.S()),
+			Value: v,
+		})
+	}
+
+	sort.Sort(macrosByPosition(macros))
+
+	return macros, nil
+}
+
+// macroValue returns the Go value of a constant macro expansion and
+// whether it is an integer, floating point or string constant.
+func macroValue(v interface{}) (interface{}, bool) {
+	switch v := v.(type) {
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	case uint32:
+		return uint64(v), true
+	case uint64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	case cc.StringLitID:
+		return string(xc.Dict.S(int(v))), true
+	}
+	return nil, false
+}
+
+// GoConstFor returns the source of a Go constant specification for m
+// named name, or the upper-cased macro name if name is empty, suitable
+// for use in a const block. GoConstFor will panic if the value of m is
+// not finite.
+func GoConstFor(m *Macro, name string) string {
+	if name == "" {
+		name = UpperCaseFirst(m.Name)
+	}
+	var lit string
+	switch v := m.Value.(type) {
+	case int64:
+		lit = strconv.FormatInt(v, 10)
+	case uint64:
+		lit = strconv.FormatUint(v, 10)
+	case float64:
+		if math.IsInf(v, 0) || math.IsNaN(v) {
+			panic(fmt.Sprintf("binding: non-finite value for macro %s: %v", m.Name, v))
+		}
+		lit = strconv.FormatFloat(v, 'g', -1, 64)
+		if !strings.ContainsAny(lit, ".e") {
+			// Keep the constant untyped floating point.
+			lit += ".0"
+		}
+	case string:
+		lit = strconv.Quote(v)
+	default:
+		panic(fmt.Sprintf("binding: unexpected value for macro %s: %T", m.Name, m.Value))
+	}
+	return fmt.Sprintf("%s = %s", name, lit)
+}
+
+type macrosByPosition []Macro
+
+func (m macrosByPosition) Len() int { return len(m) }
+func (m macrosByPosition) Less(i, j int) bool {
+	iPos := m[i].Position()
+	jPos := m[j].Position()
+	if iPos.Filename == jPos.Filename {
+		return iPos.Line < jPos.Line
+	}
+	return iPos.Filename < jPos.Filename
+}
+func (m macrosByPosition) Swap(i, j int) { m[i], m[j] = m[j], m[i] }
@@ -0,0 +1,349 @@
+// Copyright ©2016 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package binding
+
+import (
+	"bytes"
+	"fmt"
+	"go/token"
+	"strings"
+
+	"github.com/cznic/cc"
+	"github.com/cznic/xc"
+)
+
+// Struct is a description of a C struct or union declaration.
+type Struct struct {
+	Pos token.Pos
+
+	// Kind is cc.Struct or cc.Union.
+	Kind cc.Kind
+
+	// Tag is the struct or union tag and Name is the typedef
+	// name of the type. Either may be empty, but not both.
+	Tag  string
+	Name string
+
+	Type cc.Type
+
+	// Size and Align are the size and alignment of the type
+	// in bytes. They are zero for an incomplete type.
+	Size  int
+	Align int
+
+	// Incomplete is whether the type is declared without its
+	// members, as is the case for opaque handle types.
+	Incomplete bool
+
+	Fields []Field
+}
+
+// Position returns the token position of the declaration.
+func (s *Struct) Position() token.Position { return xc.FileSet.Position(s.Pos) }
+
+// GoName returns the name of the Go type for the struct as given by
+// TypeName.
+func (s *Struct) GoName() string { return TypeName(s.Tag, s.Name) }
+
+// CName returns the cgo name of the C type, C.<typedef name> if it has
+// a typedef name and otherwise C.struct_<tag> or C.union_<tag>.
+func (s *Struct) CName() string {
+	if s.Name != "" {
+		return "C." + s.Name
+	}
+	return cStructName(s.Kind, s.Tag)
+}
+
+func cStructName(k cc.Kind, tag string) string {
+	if k == cc.Union {
+		return "C.union_" + tag
+	}
+	return "C.struct_" + tag
+}
+
+// TypeName returns the name of the Go type for a C struct, union or enum
+// type with the given tag and typedef name. The name is formed from the
+// tag if there is one, so that the type has the same name however it is
+// referred to, and otherwise from the typedef name, with leading
+// underscores removed and the first letter upper-cased.
+func TypeName(tag, typedef string) string {
+	n := tag
+	if n == "" {
+		n = typedef
+	}
+	return UpperCaseFirst(strings.TrimLeft(n, "_"))
+}
+
+// structNames returns the Go and cgo names of the struct or union type
+// typ, or of the type it points to if isPtr is true, and whether typ is
+// a struct or union type with a tag or typedef name.
+func structNames(typ cc.Type, isPtr bool) (goName, cName string, ok bool) {
+	d := typ.Declarator()
+	if isPtr {
+		typ = typ.Element()
+	}
+	k := typ.Kind()
+	if k != cc.Struct && k != cc.Union {
+		return "", "", false
+	}
+	if typ.Tag() != 0 {
+		tag := string(xc.Dict.S(typ.Tag()))
+		return TypeName(tag, ""), cStructName(k, tag), true
+	}
+	name := typedefName(d)
+	if name == "" {
+		return "", "", false
+	}
+	return TypeName("", name), "C." + name, true
+}
+
+// typedefName returns the typedef name that declared the base type of
+// the declarator d, following typedefs of typedef names, or the empty
+// string if there is none.
+func typedefName(d *cc.Declarator) string {
+	var name string
+	for d != nil {
+		id := d.RawSpecifier().TypedefName()
+		if id == 0 {
+			break
+		}
+		_, scope := d.Identifier()
+		if scope == nil {
+			return string(xc.Dict.S(id))
+		}
+		dd, ok := scope.Lookup(cc.NSIdentifiers, id).Node.(*cc.DirectDeclarator)
+		if !ok {
+			return string(xc.Dict.S(id))
+		}
+		d = dd.TopDeclarator()
+		if d.PointerOpt == nil {
+			// Typedefs of pointers do not name the base type.
+			name = string(xc.Dict.S(id))
+		}
+	}
+	return name
+}
+
+// typeID returns a key identifying the struct, union or enum type typ,
+// its tag if it has one and otherwise its declaration, so that typedefs
+// of the same type are identified.
+func typeID(typ cc.Type) interface{} {
+	if typ.Tag() != 0 {
+		return typ.Tag()
+	}
+	if typ.Kind() == cc.Enum {
+		if l := typ.EnumeratorList(); len(l) != 0 {
+			return l[0].DefTok.Pos()
+		}
+		return typ.String()
+	}
+	members, _ := typ.Members()
+	for _, m := range members {
+		if m.Declarator != nil {
+			return m.Declarator
+		}
+	}
+	return typ.String()
+}
+
+// Field is a member of a C struct or union.
+type Field struct {
+	Name string
+	Type cc.Type
+
+	// Offset, Size and Align are the offset of the field from
+	// the start of the struct, its size and its alignment in
+	// bytes.
+	Offset int
+	Size   int
+	Align  int
+
+	// Bits is the width of a bit field and is zero for other
+	// fields.
+	Bits int
+}
+
+// Structs returns the C struct and union declarations in the given set
+// of file paths, parsed with the zero Config, as described by the Structs
+// method.
+func Structs(paths ...string) ([]Struct, error) {
+	var c Config
+	return c.Structs(paths...)
+}
+
+// Structs returns the C struct and union declarations in the given set
+// of file paths that are named by a typedef or used by a function
+// declaration, a variable declaration or the field of another returned
+// struct. The files are parsed with the configuration c, and sizes,
+// alignments and offsets are those of its data model.
+func (c *Config) Structs(paths ...string) ([]Struct, error) {
+	t, err := c.parse(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	var structs []Struct
+	seen := make(map[interface{}]int)
+	var add func(typ cc.Type, name string, pos token.Pos)
+	add = func(typ cc.Type, name string, pos token.Pos) {
+		if typ == nil {
+			return
+		}
+		if n := typedefName(typ.Declarator()); n != "" {
+			name = n
+		}
+		for typ.Kind() == cc.Ptr || typ.Kind() == cc.Array {
+			typ = typ.Element()
+		}
+		k := typ.Kind()
+		if k != cc.Struct && k != cc.Union {
+			return
+		}
+		var tag string
+		if typ.Tag() != 0 {
+			tag = string(xc.Dict.S(typ.Tag()))
+		}
+		if tag == "" && name == "" {
+			// We cannot name an anonymous struct.
+			return
+		}
+
+		key := typeID(typ)
+		if i, ok := seen[key]; ok {
+			if structs[i].Name == "" {
+				structs[i].Name = name
+			}
+			return
+		}
+		seen[key] = len(structs)
+
+		s := Struct{
+			Pos:  pos,
+			Kind: k,
+			Tag:  tag,
+			Name: name,
+			Type: typ,
+		}
+		members, _ := typ.Members()
+		if len(members) == 0 {
+			s.Incomplete = true
+			structs = append(structs, s)
+			return
+		}
+		s.Size = typ.SizeOf()
+		s.Align = typ.AlignOf()
+		s.Fields = make([]Field, len(members))
+		for i, m := range members {
+			s.Fields[i] = Field{
+				Name:   string(xc.Dict.S(m.Name)),
+				Type:   m.Type,
+				Offset: m.OffsetOf,
+				Size:   m.Type.SizeOf(),
+				Align:  m.Type.AlignOf(),
+				Bits:   m.Bits,
+			}
+		}
+		structs = append(structs, s)
+		for _, m := range members {
+			add(m.Type, "", pos)
+		}
+	}
+
+	walkTypes(t, add)
+
+	return structs, nil
+}
+
+// GoStructFor returns the source of a Go struct type definition that has
+// the memory layout of s in the data model it was parsed with. Fields
+// of arithmetic and enum types are given the Go integer or floating point
+// type of the same size, pointer fields are given type uintptr and
+// padding is made explicit. Struct fields are given the GoName of their
+// type, which must also be defined. A union is defined as a byte array of
+// its size aligned as the union. GoStructFor will panic if s is incomplete
+// or has a bit field or a field whose type has no Go equivalent.
+func GoStructFor(s *Struct) string {
+	if s.Incomplete {
+		panic(fmt.Sprintf("binding: incomplete type: %s", s.Type))
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "type %s struct {\n", s.GoName())
+	if s.Kind == cc.Union {
+		fmt.Fprintf(&buf, "\t_ [0]%s\n", alignType(s.Align))
+		fmt.Fprintf(&buf, "\t_ [%d]byte\n", s.Size)
+		buf.WriteString("}\n")
+		return buf.String()
+	}
+	var off int
+	for _, f := range s.Fields {
+		if f.Bits != 0 {
+			panic(fmt.Sprintf("binding: bit field %s in %s", f.Name, s.Type))
+		}
+		if f.Offset > off {
+			fmt.Fprintf(&buf, "\t_ [%d]byte\n", f.Offset-off)
+		}
+		fmt.Fprintf(&buf, "\t%s %s\n", UpperCaseFirst(f.Name), layoutType(f.Type))
+		off = f.Offset + f.Size
+	}
+	if s.Size > off {
+		fmt.Fprintf(&buf, "\t_ [%d]byte\n", s.Size-off)
+	}
+	buf.WriteString("}\n")
+	return buf.String()
+}
+
+// layoutType returns the Go type with the memory layout of typ.
+func layoutType(typ cc.Type) string {
+	switch k := typ.Kind(); k {
+	case cc.Char, cc.SChar, cc.UChar, cc.Short, cc.UShort, cc.Int, cc.UInt,
+		cc.Long, cc.ULong, cc.LongLong, cc.ULongLong, cc.Enum:
+		if isUnsigned(k) {
+			return fmt.Sprintf("uint%d", 8*typ.SizeOf())
+		}
+		return fmt.Sprintf("int%d", 8*typ.SizeOf())
+	case cc.Bool:
+		return "bool"
+	case cc.Float:
+		return "float32"
+	case cc.Double:
+		return "float64"
+	case cc.FloatComplex:
+		return "complex64"
+	case cc.DoubleComplex:
+		return "complex128"
+	case cc.Ptr, cc.UintPtr:
+		return "uintptr"
+	case cc.Array:
+		return fmt.Sprintf("[%d]%s", typ.Elements(), layoutType(typ.Element()))
+	case cc.Struct, cc.Union:
+		if name, _, ok := structNames(typ, false); ok {
+			return name
+		}
+	}
+	panic(fmt.Sprintf("binding: no Go layout for type: %s", typ))
+}
+
+// isUnsigned returns whether values of the integer kind k are unsigned.
+func isUnsigned(k cc.Kind) bool {
+	switch k {
+	case cc.UChar, cc.UShort, cc.UInt, cc.ULong, cc.ULongLong, cc.Bool:
+		return true
+	}
+	return false
+}
+
+// alignType returns a Go type with the alignment align.
+func alignType(align int) string {
+	switch align {
+	case 1:
+		return "byte"
+	case 2:
+		return "uint16"
+	case 4:
+		return "uint32"
+	}
+	return "uint64"
+}
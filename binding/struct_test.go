@@ -0,0 +1,71 @@
+// Copyright ©2016 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package binding
+
+import "testing"
+
+func TestStructs(t *testing.T) {
+	requireHost(t)
+	structs, err := Structs("testdata/struct.h")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []struct {
+		tag, name, goName, cName string
+	}{
+		{tag: "_opts", name: "opts_t", goName: "Opts", cName: "C.opts_t"},
+		{name: "anon_t", goName: "Anon_t", cName: "C.anon_t"},
+		{tag: "outer", goName: "Outer", cName: "C.struct_outer"},
+	}
+	if len(structs) != len(want) {
+		t.Fatalf("unexpected number of structs: got %d want %d", len(structs), len(want))
+	}
+	for i, s := range structs {
+		w := want[i]
+		if s.Tag != w.tag || s.Name != w.name {
+			t.Errorf("unexpected struct %d: got tag %q name %q want tag %q name %q", i, s.Tag, s.Name, w.tag, w.name)
+		}
+		if got := s.GoName(); got != w.goName {
+			t.Errorf("unexpected Go name for %s: got %q want %q", s.Type, got, w.goName)
+		}
+		if got := s.CName(); got != w.cName {
+			t.Errorf("unexpected cgo name for %s: got %q want %q", s.Type, got, w.cName)
+		}
+	}
+
+	const wantOuter = `type Outer struct {
+	An Anon_t
+	_ [4]byte
+	O Opts
+	Arr [2]Anon_t
+	C int8
+	_ [7]byte
+}
+`
+	if got := GoStructFor(&structs[2]); got != wantOuter {
+		t.Errorf("unexpected Go struct for outer:\ngot:\n%s\nwant:\n%s", got, wantOuter)
+	}
+}
+
+func TestStructParameters(t *testing.T) {
+	d := declaration(t, "testdata/struct.h", "struct_func")
+	for i, want := range []struct {
+		goType, cgo string
+	}{
+		{goType: "Opts", cgo: "*(*C.struct__opts)(unsafe.Pointer(&q))"},
+		{goType: "*Opts", cgo: "(*C.struct__opts)(unsafe.Pointer(r))"},
+		{goType: "*Anon_t", cgo: "(*C.anon_t)(unsafe.Pointer(a))"},
+		{goType: "*Outer", cgo: "(*C.struct_outer)(unsafe.Pointer(o))"},
+	} {
+		p := d.Parameters()[i]
+		if got := GoTypeFor(p.Type(), p.Name()); got != want.goType {
+			t.Errorf("unexpected Go type for %s: got %q want %q", p.Name(), got, want.goType)
+		}
+		if got := CgoConversionFor(p.Name(), p.Type()); got != want.cgo {
+			t.Errorf("unexpected cgo conversion for %s: got %q want %q", p.Name(), got, want.cgo)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+#include <stdlib.h>
+#include "inc.h"
+
+#define CONFIG_INC INC_VALUE
+
+#ifdef CONFIG_DEFINED
+#define CONFIG_DEFINE CONFIG_DEFINED
+#else
+#define CONFIG_DEFINE 0
+#endif
+
+#ifdef CONFIG_PREDEFINED
+#define CONFIG_PREDEFINE CONFIG_PREDEFINED
+#else
+#define CONFIG_PREDEFINE 0
+#endif
+
+#ifdef __extension__
+#define CONFIG_DEFAULT 1
+#else
+#define CONFIG_DEFAULT 0
+#endif
+
+size_t config_func(inc_real x);
@@ -0,0 +1,3 @@
+typedef double *dptr;
+
+void const_func(const double *a, double *const b, const double **c, double *d, const double *const e, double const *f, dptr g, const char *s);
@@ -0,0 +1,12 @@
+typedef enum {
+	RowMajor = 101,
+	ColMajor = 102
+} CBLAS_ORDER;
+
+enum tagged {
+	A = 1,
+	B = 2,
+	C = B
+};
+
+void enum_func(CBLAS_ORDER o, enum tagged t);
@@ -0,0 +1,3 @@
+typedef double inc_real;
+
+#define INC_VALUE 3
@@ -0,0 +1 @@
+long long_func(long a, unsigned long *b, int c, int *d);
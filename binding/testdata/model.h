@@ -0,0 +1,18 @@
+#include <stddef.h>
+
+struct sizes {
+	size_t n;
+	long l;
+};
+
+struct ptr {
+	char c;
+	void *p;
+};
+
+struct dbl {
+	char c;
+	double d;
+};
+
+void model(struct sizes *s, struct ptr *p, struct dbl *d);
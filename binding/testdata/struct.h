@@ -0,0 +1,19 @@
+typedef struct _opts {
+	int a;
+	double b;
+} opts_t;
+
+typedef struct {
+	int x;
+} anon_t;
+
+typedef anon_t anon2_t;
+
+struct outer {
+	anon2_t an;
+	opts_t o;
+	anon_t arr[2];
+	char c;
+};
+
+void struct_func(opts_t q, struct _opts *r, anon2_t *a, struct outer *o);
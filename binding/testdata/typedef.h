@@ -0,0 +1,3 @@
+typedef int lapack_int;
+
+lapack_int typedef_func(lapack_int n, int m, const lapack_int *a, lapack_int *b);
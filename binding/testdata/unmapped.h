@@ -0,0 +1 @@
+void unmapped_func(long double x, int n, long double *y);
@@ -0,0 +1,189 @@
+// Copyright ©2016 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package binding
+
+import (
+	"errors"
+	"fmt"
+	"go/token"
+	"strings"
+	"text/template"
+
+	"github.com/cznic/cc"
+	"github.com/cznic/xc"
+)
+
+var (
+	// ErrNoMapping is the error of a TypeError for a type that
+	// has no mapping.
+	ErrNoMapping = errors.New("no type mapping")
+
+	// ErrNotEnum is the error of a TypeError for a type passed
+	// to an enum mapping that is not an enum type.
+	ErrNotEnum = errors.New("not an enum type")
+)
+
+// TypeError is an error mapping the type of a parameter or the result of
+// a C function declaration.
+type TypeError struct {
+	Pos token.Pos
+
+	// Decl is the name of the declaration and Param is the name
+	// of the parameter, or the empty string for the result.
+	Decl  string
+	Param string
+
+	Type cc.Type
+
+	// Err is ErrNoMapping, ErrNotEnum or the error returned
+	// by the execution of the mapping template.
+	Err error
+}
+
+// Position returns the token position of the declaration.
+func (e *TypeError) Position() token.Position { return xc.FileSet.Position(e.Pos) }
+
+func (e *TypeError) Error() string {
+	if e.Decl == "" {
+		if e.Pos.IsValid() {
+			return fmt.Sprintf("binding: %v: %v", e.Position(), e.Err)
+		}
+		return fmt.Sprintf("binding: %v", e.Err)
+	}
+	what := "result"
+	if e.Param != "" {
+		what = "parameter " + e.Param
+	}
+	return fmt.Sprintf("binding: %v: %s %s: %v: %v", e.Position(), e.Decl, what, e.Type, e.Err)
+}
+
+// TypeErrors is a list of TypeErrors. It allows all the type mapping
+// failures of a set of declarations to be reported in one pass:
+//  var errs binding.TypeErrors
+//  for _, d := range decls {
+//  	for _, p := range d.Parameters() {
+//  		typ, err := d.GoTypeFor(&p, p.Name(), types)
+//  		if errs.Add(err) {
+//  			continue
+//  		}
+//  		...
+//  	}
+//  }
+//  if errs != nil {
+//  	return errs
+//  }
+type TypeErrors []*TypeError
+
+// Add appends err to e and returns true if err is not nil. An error that
+// is not a *TypeError is added as the Err field of a TypeError with no
+// declaration.
+func (e *TypeErrors) Add(err error) bool {
+	if err == nil {
+		return false
+	}
+	te, ok := err.(*TypeError)
+	if !ok {
+		te = &TypeError{Err: err}
+	}
+	*e = append(*e, te)
+	return true
+}
+
+func (e TypeErrors) Error() string {
+	s := make([]string, len(e))
+	for i, err := range e {
+		s[i] = err.Error()
+	}
+	return strings.Join(s, "\n")
+}
+
+// Unmapped returns the distinct keys of the types in e that have no
+// mapping, in the order they were added.
+func (e TypeErrors) Unmapped() []TypeKey {
+	var keys []TypeKey
+	seen := make(map[TypeKey]bool)
+	for _, err := range e {
+		if err.Err != ErrNoMapping || err.Type == nil {
+			continue
+		}
+		k := TypeKeyFor(err.Type)
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// typeError returns a *TypeError for the failure err to map the type of
+// p, or of the result of d if p is nil, or nil if err is nil. If p has no
+// name, the error is reported for name.
+func (d *Declaration) typeError(p *Parameter, name string, err error) error {
+	if err == nil {
+		return nil
+	}
+	e := &TypeError{Pos: d.Pos, Decl: d.Name, Type: d.Return, Err: err}
+	if p != nil {
+		e.Param = p.Name()
+		if e.Param == "" {
+			e.Param = name
+		}
+		e.Type = p.Type()
+	}
+	return e
+}
+
+// typeOf returns the type of p, or of the result of d if p is nil.
+func (d *Declaration) typeOf(p *Parameter) cc.Type {
+	if p == nil {
+		return d.Return
+	}
+	return p.Type()
+}
+
+// GoTypeFor returns the Go type of the parameter p of d, or of its result if
+// p is nil, as described by the GoTypeFor function. If no type mapping is found
+// or the template fails, GoTypeFor returns a *TypeError.
+func (d *Declaration) GoTypeFor(p *Parameter, name string, types ...map[TypeKey]*template.Template) (string, error) {
+	s, err := goTypeFor(d.typeOf(p), name, types)
+	return s, d.typeError(p, name, err)
+}
+
+// GoTypeForEnum returns the Go type of the enum parameter p of d, or of its
+// result if p is nil, as described by the GoTypeForEnum function. If no type
+// mapping is found, the template fails or the type is not an enum,
+// GoTypeForEnum returns a *TypeError.
+func (d *Declaration) GoTypeForEnum(p *Parameter, name string, types ...map[string]*template.Template) (string, error) {
+	s, err := enumFor(d.typeOf(p), name, types)
+	return s, d.typeError(p, name, err)
+}
+
+// CgoConversionFor returns the conversion of the Go value name to the type of
+// the parameter p of d, as described by the CgoConversionFor function. If no
+// type mapping is found or the template fails, CgoConversionFor returns a
+// *TypeError.
+func (d *Declaration) CgoConversionFor(p *Parameter, name string, types ...map[TypeKey]*template.Template) (string, error) {
+	s, err := cgoConversionFor(name, d.typeOf(p), types)
+	return s, d.typeError(p, name, err)
+}
+
+// CgoConversionForEnum returns the conversion of the Go value name to the enum
+// type of the parameter p of d, as described by the CgoConversionForEnum
+// function. If no type mapping is found, the template fails or the type is not
+// an enum, CgoConversionForEnum returns a *TypeError.
+func (d *Declaration) CgoConversionForEnum(p *Parameter, name string, types ...map[string]*template.Template) (string, error) {
+	s, err := enumFor(d.typeOf(p), name, types)
+	return s, d.typeError(p, name, err)
+}
+
+// GoResultFor returns the conversion of the C value name of the result type of
+// d to the Go type goType, as described by the GoResultFor function. If the
+// result has no conversion or the template fails, GoResultFor returns a
+// *TypeError.
+func (d *Declaration) GoResultFor(name, goType string, types ...map[TypeKey]*template.Template) (string, error) {
+	s, err := goResultFor(name, d.Return, goType, types)
+	return s, d.typeError(nil, name, err)
+}
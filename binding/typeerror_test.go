@@ -0,0 +1,59 @@
+// Copyright ©2016 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package binding
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cznic/cc"
+)
+
+func TestTypeErrors(t *testing.T) {
+	d := declaration(t, "testdata/unmapped.h", "unmapped_func")
+
+	var errs TypeErrors
+	for _, p := range d.Parameters() {
+		_, err := d.GoTypeFor(&p, p.Name())
+		errs.Add(err)
+	}
+	if len(errs) != 2 {
+		t.Fatalf("unexpected number of errors: got %d want 2:\n%v", len(errs), errs)
+	}
+	for i, param := range []string{"x", "y"} {
+		err := errs[i]
+		if err.Decl != "unmapped_func" || err.Param != param || err.Err != ErrNoMapping {
+			t.Errorf("unexpected error %d: %v", i, err)
+		}
+	}
+
+	other := errors.New("template failed")
+	if !errs.Add(other) {
+		t.Fatal("expected non-nil error to be added")
+	}
+	if len(errs) != 3 || errs[2].Err != other {
+		t.Fatalf("unexpected wrapped error: %v", errs[len(errs)-1])
+	}
+	if got, want := errs[2].Error(), "binding: template failed"; got != want {
+		t.Errorf("unexpected wrapped error message: got %q want %q", got, want)
+	}
+	if errs.Add(nil) {
+		t.Error("unexpected nil error added")
+	}
+
+	want := []TypeKey{
+		{Kind: cc.LongDouble},
+		{Kind: cc.LongDouble, IsPointer: true},
+	}
+	got := errs.Unmapped()
+	if len(got) != len(want) {
+		t.Fatalf("unexpected unmapped keys: got %+v want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("unexpected unmapped key %d: got %+v want %+v", i, got[i], want[i])
+		}
+	}
+}